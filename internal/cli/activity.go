@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -14,6 +14,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/user/gitlab-cli/internal/config"
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+	"github.com/user/gitlab-cli/internal/timerange"
 )
 
 var activityCmd = &cobra.Command{
@@ -24,17 +26,113 @@ var activityCmd = &cobra.Command{
 var activityListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List user activities (defaults to current month)",
-	RunE:  runActivityList,
+	Long: `List activity events, by default your own for the current month.
+
+--user lists the events of other users (repeatable or comma-separated),
+--team those of every user in a team file (one username per line) or every
+member of a group, and --project the events of a project. With --project,
+--user and --team keep only the events of those users.
+
+--group-by-user groups the output per user; combined with --group-by-task
+each user's events are grouped by date and task.`,
+	RunE: runActivityList,
+}
+
+var activityTimesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Estimate time spent per task and day from activity",
+	Long: `Estimate the time spent per task and day from event timestamps.
+
+Events are grouped into work sessions: a pause longer than --gap ends a
+session, the first event of a session is credited --first-event of preceding
+work, and the time between two events of a session goes to the task of the
+later event. Daily totals per task are rounded to --round.
+
+Output as a table with per-project totals, CSV, JSON, or iCalendar with one
+event per work block. --time-stats adds the time logged and estimated on the
+task's MRs in GitLab.
+
+Defaults come from the timesheet section of the config file:
+
+  timesheet:
+    gap: 1h
+    first_event: 30m
+    round: 15m
+    rounding: up`,
+	RunE: runActivityTimesheet,
+}
+
+var activityReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Render an activity report as Markdown or HTML",
+	Long: `Render an activity report for status emails: a section per task with
+links to the MRs, issues, commits and pipelines involved, event counts per
+project and action, and with --charts an inline SVG chart of daily activity.
+
+The report is rendered with a Go template. The built-in templates can be
+replaced by ~/.gitlab-cli/templates/report.md.tmpl and report.html.tmpl, or
+by a file given with --template. Templates receive .Title, .From, .To,
+.Generated, .Total, .Tasks (each with .Task, .Projects and .Events, whose
+entries have the activity list --json fields plus .URL), .Projects, .Actions
+and .Days (each with .Name and .Count), and .Chart. Markdown templates can
+escape text with the md function.`,
+	RunE: runActivityReport,
+}
+
+var activitySyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Store new activity events locally",
+	Long: `Fetch the events since the last sync, enrich them like activity list and
+append them to the local store in ~/.gitlab-cli/activity/<host>/.
+
+The first sync starts at --from, by default three months ago. Afterwards
+list, timesheet and report accept --offline to read the store instead of
+GitLab, which also keeps events GitLab no longer returns.`,
+	RunE: runActivitySync,
+}
+
+var activityPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove old events from the local store",
+	RunE:  runActivityPrune,
 }
 
 var (
 	activityPrev        bool
 	activityFrom        string
 	activityTo          string
+	activitySince       string
+	activityWeek        bool
+	activityLastWeek    bool
+	activityQuarter     bool
 	activityJSON        bool
 	activityFormat      string
 	activityGroupByTask bool
+	activityGroupByUser bool
 	activityPipelines   bool
+	activityUsers       []string
+	activityProject     string
+	activityTeam        string
+	activityOffline     bool
+
+	// activity timesheet flags
+	timesheetFormat     string
+	timesheetGap        time.Duration
+	timesheetFirstEvent time.Duration
+	timesheetRound      time.Duration
+	timesheetRounding   string
+	timesheetTimeStats  bool
+
+	// activity sync and prune flags
+	syncFrom       string
+	pruneBefore    string
+	pruneOlderThan string
+
+	// activity report flags
+	reportFormat   string
+	reportTemplate string
+	reportCharts   bool
+	reportTitle    string
 )
 
 const (
@@ -46,30 +144,57 @@ func init() {
 	rootCmd.AddCommand(activityCmd)
 	activityCmd.AddCommand(activityListCmd)
 
-	activityListCmd.Flags().BoolVar(&activityPrev, "prev", false, "show previous month")
-	activityListCmd.Flags().StringVar(&activityFrom, "from", "", "start date (YYYY-MM-DD)")
-	activityListCmd.Flags().StringVar(&activityTo, "to", "", "end date (YYYY-MM-DD)")
+	addActivityRangeFlags(activityListCmd)
 	activityListCmd.Flags().BoolVar(&activityJSON, "json", false, "output as JSON")
 	activityListCmd.Flags().StringVar(&activityFormat, "format", "", "output format (csv)")
 	activityListCmd.Flags().BoolVar(&activityGroupByTask, "group-by-task", false, "group activities by task")
+	activityListCmd.Flags().BoolVar(&activityGroupByUser, "group-by-user", false, "group activities by user")
 	activityListCmd.Flags().BoolVar(&activityPipelines, "pipelines", false, "include pipeline runs from assigned MRs")
+	activityListCmd.Flags().StringSliceVar(&activityUsers, "user", nil, "list the activity of these users instead of your own")
+	activityListCmd.Flags().StringVar(&activityProject, "project", "", "list the activity of a project (ID or path)")
+	activityListCmd.Flags().StringVar(&activityTeam, "team", "", "list the activity of a team: a configured team, a file of usernames or a group")
+	activityListCmd.Flags().BoolVar(&activityOffline, "offline", false, "read the local store filled by activity sync")
+
+	activityCmd.AddCommand(activityTimesheetCmd)
+	addActivityRangeFlags(activityTimesheetCmd)
+	activityTimesheetCmd.Flags().BoolVar(&activityPipelines, "pipelines", false, "count pipeline runs from assigned MRs as events")
+	activityTimesheetCmd.Flags().StringVar(&timesheetFormat, "format", "table", "output format: table, csv, json, ical")
+	activityTimesheetCmd.Flags().DurationVar(&timesheetGap, "gap", 0, "pause that ends a work session (default from config, 1h)")
+	activityTimesheetCmd.Flags().DurationVar(&timesheetFirstEvent, "first-event", 0, "work credited before a session's first event (default from config, 30m)")
+	activityTimesheetCmd.Flags().DurationVar(&timesheetRound, "round", 0, "rounding unit of daily totals, 0 disables (default from config, 15m)")
+	activityTimesheetCmd.Flags().StringVar(&timesheetRounding, "rounding", "", "rounding mode: up, nearest, down (default from config, up)")
+	activityTimesheetCmd.Flags().BoolVar(&timesheetTimeStats, "time-stats", false, "compare with time logged on the tasks' MRs")
+	activityTimesheetCmd.Flags().BoolVar(&activityOffline, "offline", false, "read the local store filled by activity sync")
+
+	activityCmd.AddCommand(activityReportCmd)
+	addActivityRangeFlags(activityReportCmd)
+	activityReportCmd.Flags().StringSliceVar(&activityUsers, "user", nil, "report the activity of these users instead of your own")
+	activityReportCmd.Flags().StringVar(&activityProject, "project", "", "report the activity of a project (ID or path)")
+	activityReportCmd.Flags().StringVar(&activityTeam, "team", "", "report the activity of a team: a configured team, a file of usernames or a group")
+	activityReportCmd.Flags().BoolVar(&activityPipelines, "pipelines", false, "include pipeline runs from assigned MRs")
+	activityReportCmd.Flags().StringVar(&reportFormat, "format", "markdown", "output format: markdown, html")
+	activityReportCmd.Flags().StringVar(&reportTemplate, "template", "", "template file replacing the built-in one")
+	activityReportCmd.Flags().BoolVar(&reportCharts, "charts", false, "include an SVG chart of daily activity")
+	activityReportCmd.Flags().StringVar(&reportTitle, "title", "Activity report", "report title")
+	activityReportCmd.Flags().BoolVar(&activityOffline, "offline", false, "read the local store filled by activity sync")
+
+	activityCmd.AddCommand(activitySyncCmd)
+	activitySyncCmd.Flags().StringVar(&syncFrom, "from", "", "start of the first sync (YYYY-MM-DD, default three months ago)")
+
+	activityCmd.AddCommand(activityPruneCmd)
+	activityPruneCmd.Flags().StringVar(&pruneBefore, "before", "", "remove events before this date (YYYY-MM-DD)")
+	activityPruneCmd.Flags().StringVar(&pruneOlderThan, "older-than", "", "remove events older than this, e.g. 6m, 1y")
 }
 
-func getMonthRange(prev bool) (string, string) {
-	now := time.Now()
-	var year int
-	var month time.Month
-
-	if prev {
-		year, month, _ = now.AddDate(0, -1, 0).Date()
-	} else {
-		year, month, _ = now.Date()
-	}
-
-	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
-	lastDay := firstDay.AddDate(0, 1, -1)
-
-	return firstDay.Format("2006-01-02"), lastDay.Format("2006-01-02")
+// addActivityRangeFlags registers the flags that select the date range.
+func addActivityRangeFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&activityPrev, "prev", false, "previous month (or quarter with --quarter)")
+	cmd.Flags().StringVar(&activityFrom, "from", "", "start date (YYYY-MM-DD or ISO week YYYY-Www)")
+	cmd.Flags().StringVar(&activityTo, "to", "", "end date (YYYY-MM-DD or ISO week YYYY-Www)")
+	cmd.Flags().StringVar(&activitySince, "since", "", "from this long ago until today, e.g. 10d, 2w, 3m")
+	cmd.Flags().BoolVar(&activityWeek, "week", false, "current week (Monday to Sunday)")
+	cmd.Flags().BoolVar(&activityLastWeek, "last-week", false, "previous week")
+	cmd.Flags().BoolVar(&activityQuarter, "quarter", false, "current quarter")
 }
 
 func runActivityList(cmd *cobra.Command, args []string) error {
@@ -82,40 +207,198 @@ func runActivityList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	rng, err := activityRange(loc)
+	if err != nil {
+		return err
+	}
+	fromDate, toDate := rng.FromDate(), rng.ToDate()
+
+	opts, err := activityEventOptions(cfg, client)
+	if err != nil {
+		return err
+	}
 
-	// Determine date range
-	var fromDate, toDate string
-	if activityFrom != "" || activityTo != "" {
-		fromDate = activityFrom
-		toDate = activityTo
-	} else {
-		fromDate, toDate = getMonthRange(activityPrev)
+	activities, _, err := loadActivities(cfg, client, opts, rng, loc, activityPipelines)
+	if err != nil {
+		return err
 	}
 
-	// Validate date format if provided
-	if activityFrom != "" {
-		if _, err := time.Parse("2006-01-02", activityFrom); err != nil {
-			return fmt.Errorf("invalid --from date format, use YYYY-MM-DD")
+	// Output based on format
+	if activityGroupByUser {
+		if activityJSON {
+			return outputUserGroupedJSON(activities, activityGroupByTask)
+		}
+		if activityFormat != "csv" {
+			return outputUserGroupedTable(activities, fromDate, toDate, activityGroupByTask)
 		}
 	}
-	if activityTo != "" {
-		if _, err := time.Parse("2006-01-02", activityTo); err != nil {
-			return fmt.Errorf("invalid --to date format, use YYYY-MM-DD")
+	if activityJSON {
+		if activityGroupByTask {
+			return outputGroupedJSON(activities)
+		}
+		return outputJSON(activities)
+	}
+	if activityFormat == "csv" {
+		return outputCSV(activities)
+	}
+	if activityGroupByTask {
+		return outputGroupedTable(activities, fromDate, toDate)
+	}
+	return outputTable(activities, fromDate, toDate)
+}
+
+// activityRange returns the date range selected by the range flags, by
+// default the current (or with --prev, previous) month. Day boundaries are
+// those of loc.
+func activityRange(loc *time.Location) (timerange.Range, error) {
+	now := time.Now().In(loc)
+
+	selected := 0
+	for _, set := range []bool{activityFrom != "" || activityTo != "", activitySince != "", activityWeek, activityLastWeek, activityQuarter} {
+		if set {
+			selected++
 		}
 	}
+	if selected > 1 {
+		return timerange.Range{}, fmt.Errorf("use only one of --from/--to, --since, --week, --last-week and --quarter")
+	}
 
-	// Fetch events
-	events, err := client.GetEvents(gitlab.ListEventsOptions{
-		After:  fromDate,
-		Before: toDate,
-	})
+	offset := 0
+	if activityPrev {
+		offset = -1
+	}
+
+	switch {
+	case activityFrom != "" || activityTo != "":
+		var r timerange.Range
+		if activityFrom != "" {
+			from, err := timerange.Parse(activityFrom, loc)
+			if err != nil {
+				return timerange.Range{}, fmt.Errorf("--from: %w", err)
+			}
+			r.From = from.From
+		}
+		if activityTo != "" {
+			to, err := timerange.Parse(activityTo, loc)
+			if err != nil {
+				return timerange.Range{}, fmt.Errorf("--to: %w", err)
+			}
+			r.To = to.To
+		}
+		return r, nil
+	case activitySince != "":
+		return timerange.Since(now, activitySince)
+	case activityWeek:
+		return timerange.Week(now, 0), nil
+	case activityLastWeek:
+		return timerange.Week(now, -1), nil
+	case activityQuarter:
+		return timerange.Quarter(now, offset), nil
+	}
+	return timerange.Month(now, offset), nil
+}
+
+// activityEventOptions returns the event selection of --user, --team and
+// --project.
+func activityEventOptions(cfg *config.Config, client *gitlab.Client) (gitlab.ListEventsOptions, error) {
+	opts := gitlab.ListEventsOptions{
+		Users:     activityUsers,
+		ProjectID: activityProject,
+	}
+	if activityTeam != "" {
+		members, err := resolveTeam(cfg, client, activityTeam)
+		if err != nil {
+			return opts, err
+		}
+		opts.Users = append(opts.Users, members...)
+	}
+	if activityPipelines && (len(opts.Users) > 0 || opts.ProjectID != "") {
+		return opts, fmt.Errorf("--pipelines covers only your own MRs and cannot be combined with --user, --team or --project")
+	}
+	return opts, nil
+}
+
+// resolveTeam returns the usernames of a team, given as the name of a team
+// in the config, the path of a team file or a group whose members form the
+// team.
+func resolveTeam(cfg *config.Config, client *gitlab.Client, team string) ([]string, error) {
+	if t, ok := cfg.Teams[team]; ok {
+		return t.Usernames(), nil
+	}
+	if info, err := os.Stat(team); err == nil && !info.IsDir() {
+		return config.ReadTeamFile(team)
+	}
+
+	members, err := client.ListGroupMembers(team, "")
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("team '%s' is neither a file nor a group: %w", team, err)
+	}
+	users := make([]string, 0, len(members))
+	for _, m := range members {
+		users = append(users, m.Username)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("group '%s' has no members", team)
+	}
+	return users, nil
+}
+
+// collectActivities fetches the events of rng selected by opts and turns
+// them into activity entries dated in loc, optionally with the pipelines of
+// assigned MRs. The MRs looked up along the way are returned keyed by
+// "projectID-mrIID".
+func collectActivities(client *gitlab.Client, tasks *task.Matcher, opts gitlab.ListEventsOptions, rng timerange.Range, loc *time.Location, pipelines bool) ([]gitlab.ActivityEntry, map[string]*gitlab.MergeRequest, error) {
+	// Fetch events. The API filters by UTC date, so fetch a wider window and
+	// keep the events within the range in the user's zone.
+	opts.After, opts.Before = rng.APIBounds()
+	fetched, err := client.GetEvents(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	events := fetched[:0]
+	for _, event := range fetched {
+		if t, err := time.Parse(time.RFC3339, event.CreatedAt); err == nil && !rng.Contains(t) {
+			continue
+		}
+		events = append(events, event)
 	}
 
-	// Build project cache and default branch cache
+	activities, projectCache, mrCache := enrichEvents(client, tasks, events, loc)
+
+	// Optionally fetch pipeline activities
+	if pipelines {
+		pipelineActivities, err := fetchPipelineActivities(client, tasks, projectCache, rng, loc)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to fetch pipeline activities: %v\n", err)
+		} else {
+			activities = append(activities, pipelineActivities...)
+			sort.Slice(activities, func(i, j int) bool {
+				if activities[i].Date != activities[j].Date {
+					return activities[i].Date > activities[j].Date
+				}
+				return activities[i].Time > activities[j].Time
+			})
+		}
+	}
+
+	return activities, mrCache, nil
+}
+
+// enrichEvents turns events into activity entries, in the same order,
+// looking up their projects, MRs and commits. It also returns the project
+// names by ID and the MRs looked up, keyed by "projectID-mrIID".
+func enrichEvents(client *gitlab.Client, tasks *task.Matcher, events []gitlab.Event, loc *time.Location) ([]gitlab.ActivityEntry, map[int]string, map[string]*gitlab.MergeRequest) {
+	// Build project name, path, URL and default branch caches
 	projectCache := make(map[int]string)
+	projectPaths := make(map[int]string)
+	projectURLs := make(map[int]string)
 	defaultBranchCache := make(map[int]string)
 	for _, event := range events {
 		if event.ProjectID > 0 {
@@ -123,6 +406,8 @@ func runActivityList(cmd *cobra.Command, args []string) error {
 				proj, err := client.GetProject(event.ProjectID)
 				if err == nil {
 					projectCache[event.ProjectID] = proj.Name
+					projectPaths[event.ProjectID] = proj.PathWithNamespace
+					projectURLs[event.ProjectID] = proj.WebURL
 					defaultBranchCache[event.ProjectID] = proj.DefaultBranch
 				} else {
 					projectCache[event.ProjectID] = fmt.Sprintf("%d", event.ProjectID)
@@ -138,45 +423,20 @@ func runActivityList(cmd *cobra.Command, args []string) error {
 	// Transform to ActivityEntry
 	activities := make([]gitlab.ActivityEntry, 0, len(events))
 	for _, event := range events {
-		entry := transformEvent(event, projectCache, defaultBranchCache, mrCache, client)
-		activities = append(activities, entry)
-	}
-
-	// Optionally fetch pipeline activities
-	if activityPipelines {
-		pipelineActivities, err := fetchPipelineActivities(client, projectCache, fromDate, toDate)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to fetch pipeline activities: %v\n", err)
-		} else {
-			activities = append(activities, pipelineActivities...)
-			sort.Slice(activities, func(i, j int) bool {
-				if activities[i].Date != activities[j].Date {
-					return activities[i].Date > activities[j].Date
-				}
-				return activities[i].Time > activities[j].Time
-			})
+		entry := transformEvent(event, loc, projectCache, projectPaths, defaultBranchCache, mrCache, client, tasks)
+		if u := eventURL(event, projectURLs[event.ProjectID]); u != "" {
+			entry.Details["web_url"] = u
 		}
+		activities = append(activities, entry)
 	}
 
-	// Output based on format
-	if activityJSON {
-		if activityGroupByTask {
-			return outputGroupedJSON(activities)
-		}
-		return outputJSON(activities)
-	}
-	if activityFormat == "csv" {
-		return outputCSV(activities)
-	}
-	if activityGroupByTask {
-		return outputGroupedTable(activities, fromDate, toDate)
-	}
-	return outputTable(activities, fromDate, toDate)
+	return activities, projectCache, mrCache
 }
 
-func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBranchCache map[int]string, mrCache map[string]*gitlab.MergeRequest, client *gitlab.Client) gitlab.ActivityEntry {
+func transformEvent(event gitlab.Event, loc *time.Location, projectCache, projectPaths map[int]string, defaultBranchCache map[int]string, mrCache map[string]*gitlab.MergeRequest, client *gitlab.Client, tasks *task.Matcher) gitlab.ActivityEntry {
 	// Parse timestamp
 	t, _ := time.Parse(time.RFC3339, event.CreatedAt)
+	t = t.In(loc)
 	date := t.Format("2006-01-02")
 	timeStr := t.Format("15:04")
 
@@ -186,6 +446,12 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 		projectName = projectCache[event.ProjectID]
 	}
 
+	// Task patterns are looked up by project path, or ID when unknown
+	scope := projectPaths[event.ProjectID]
+	if scope == "" {
+		scope = strconv.Itoa(event.ProjectID)
+	}
+
 	// Build description, details, and branch info
 	description := ""
 	details := make(map[string]interface{})
@@ -217,7 +483,7 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 			details["action"] = "deleted"
 		}
 		// Extract task from branch name first
-		task = extractTaskFromBranch(pd.Ref)
+		task = tasks.FromBranch(scope, pd.Ref)
 		// If no task in branch and it's a default branch, try commit message
 		if task == "" && pd.CommitCount > 0 {
 			defaultBranch := defaultBranchCache[event.ProjectID]
@@ -225,9 +491,9 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 				// Fetch latest commit to extract task
 				commits, err := client.GetCommits(event.ProjectID, pd.Ref, 1)
 				if err == nil && len(commits) > 0 {
-					task = extractTaskFromString(commits[0].Title)
+					task = tasks.FromText(scope, commits[0].Title)
 					if task == "" {
-						task = extractTaskFromString(commits[0].Message)
+						task = tasks.FromText(scope, commits[0].Message)
 					}
 				}
 			}
@@ -238,9 +504,9 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 			source = mr.SourceBranch
 			target = mr.TargetBranch
 			// Extract task from source branch first, then MR title
-			task = extractTaskFromBranch(mr.SourceBranch)
+			task = tasks.FromBranch(scope, mr.SourceBranch)
 			if task == "" {
-				task = extractTaskFromString(mr.Title)
+				task = tasks.FromText(scope, mr.Title)
 			}
 		}
 		description = fmt.Sprintf("MR !%d: %s", event.TargetIID, event.TargetTitle)
@@ -263,9 +529,9 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 			if mr != nil {
 				source = mr.SourceBranch
 				target = mr.TargetBranch
-				task = extractTaskFromBranch(mr.SourceBranch)
+				task = tasks.FromBranch(scope, mr.SourceBranch)
 				if task == "" {
-					task = extractTaskFromString(mr.Title)
+					task = tasks.FromText(scope, mr.Title)
 				}
 			}
 		}
@@ -279,7 +545,7 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 
 	// Final fallback: extract task from TargetTitle if still unassigned
 	if task == "" && event.TargetTitle != "" {
-		task = extractTaskFromString(event.TargetTitle)
+		task = tasks.FromText(scope, event.TargetTitle)
 	}
 
 	return gitlab.ActivityEntry{
@@ -290,11 +556,38 @@ func transformEvent(event gitlab.Event, projectCache map[int]string, defaultBran
 		Source:      source,
 		Target:      target,
 		Task:        task,
+		Author:      event.AuthorUsername,
 		Description: description,
 		Details:     details,
 	}
 }
 
+// eventURL links an event to the MR, issue, commit or branch it concerns,
+// or returns "" when the project URL is unknown.
+func eventURL(event gitlab.Event, projectURL string) string {
+	if projectURL == "" {
+		return ""
+	}
+	iid := event.TargetIID
+	if event.Note != nil && event.Note.NoteableIID > 0 {
+		iid = event.Note.NoteableIID
+	}
+	switch {
+	case event.PushData != nil:
+		if event.PushData.CommitTo != "" {
+			return projectURL + "/-/commit/" + event.PushData.CommitTo
+		}
+		if event.PushData.Action != "removed" && event.PushData.Ref != "" {
+			return projectURL + "/-/tree/" + event.PushData.Ref
+		}
+	case event.TargetType == "MergeRequest", event.Note != nil && event.Note.NoteableType == "MergeRequest":
+		return fmt.Sprintf("%s/-/merge_requests/%d", projectURL, iid)
+	case event.TargetType == "Issue", event.Note != nil && event.Note.NoteableType == "Issue":
+		return fmt.Sprintf("%s/-/issues/%d", projectURL, iid)
+	}
+	return ""
+}
+
 func getMRCached(projectID, mrIID int, cache map[string]*gitlab.MergeRequest, client *gitlab.Client) *gitlab.MergeRequest {
 	key := fmt.Sprintf("%d-%d", projectID, mrIID)
 	if mr, ok := cache[key]; ok {
@@ -312,28 +605,27 @@ func getMRCached(projectID, mrIID int, cache map[string]*gitlab.MergeRequest, cl
 	return mr
 }
 
-var taskRegex = regexp.MustCompile(`#(\d{5})`)
-var branchTaskRegex = regexp.MustCompile(`(?:^|/)(\d{5})-`)
-
-func extractTaskFromBranch(branchName string) string {
-	// Try branch-specific pattern first: feature/12345-desc or 12345-desc
-	matches := branchTaskRegex.FindStringSubmatch(branchName)
-	if len(matches) > 1 {
-		return "#" + matches[1]
+// taskMatcher returns the task matcher of the patterns in cfg. Invalid
+// patterns are reported and the default patterns are used instead.
+func taskMatcher(cfg *config.Config) *task.Matcher {
+	m, err := task.NewMatcher(cfg.Tasks)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v; using the default task patterns\n", err)
+		return task.DefaultMatcher()
 	}
-	// Fall back to #12345 pattern
-	return extractTaskFromString(branchName)
+	return m
 }
 
-func extractTaskFromString(s string) string {
-	matches := taskRegex.FindStringSubmatch(s)
-	if len(matches) > 1 {
-		return "#" + matches[1]
+// mrProjectPath returns the path of the project an MR belongs to, for task
+// pattern lookup.
+func mrProjectPath(mr *gitlab.MergeRequest) string {
+	if mr.References.Full != "" {
+		return task.ProjectFromReference(mr.References.Full)
 	}
-	return ""
+	return strconv.Itoa(mr.ProjectID)
 }
 
-func fetchPipelineActivities(client *gitlab.Client, projectCache map[int]string, fromDate, toDate string) ([]gitlab.ActivityEntry, error) {
+func fetchPipelineActivities(client *gitlab.Client, tasks *task.Matcher, projectCache map[int]string, rng timerange.Range, loc *time.Location) ([]gitlab.ActivityEntry, error) {
 	mrs, err := client.ListMRs(gitlab.ListMROptions{
 		Scope:   "assigned_to_me",
 		State:   "all",
@@ -344,14 +636,6 @@ func fetchPipelineActivities(client *gitlab.Client, projectCache map[int]string,
 	}
 
 	var activities []gitlab.ActivityEntry
-	var fromTime, toTime time.Time
-	if fromDate != "" {
-		fromTime, _ = time.Parse("2006-01-02", fromDate)
-	}
-	if toDate != "" {
-		toTime, _ = time.Parse("2006-01-02", toDate)
-		toTime = toTime.Add(24*time.Hour - time.Second)
-	}
 
 	for _, mr := range mrs {
 		pipelines, err := client.GetMRPipelines(mr.ProjectID, mr.IID)
@@ -370,17 +654,15 @@ func fetchPipelineActivities(client *gitlab.Client, projectCache map[int]string,
 				continue
 			}
 
-			if !fromTime.IsZero() && pTime.Before(fromTime) {
-				continue
-			}
-			if !toTime.IsZero() && pTime.After(toTime) {
+			if !rng.Contains(pTime) {
 				continue
 			}
+			pTime = pTime.In(loc)
 
 			description := fmt.Sprintf("pipeline %s", p.Status)
-			task := extractTaskFromBranch(p.Ref)
+			task := tasks.FromBranch(mrProjectPath(&mr), p.Ref)
 			if task == "" {
-				task = extractTaskFromString(mr.Title)
+				task = tasks.FromText(mrProjectPath(&mr), mr.Title)
 			}
 
 			activities = append(activities, gitlab.ActivityEntry{
@@ -421,10 +703,25 @@ func outputTable(activities []gitlab.ActivityEntry, from, to string) error {
 		return nil
 	}
 
+	// Show authors only when the events are not all from one user
+	showAuthor := false
+	for _, a := range activities {
+		if a.Author != activities[0].Author {
+			showAuthor = true
+			break
+		}
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if showAuthor {
+		fmt.Fprint(w, "AUTHOR\t")
+	}
 	fmt.Fprintln(w, "DATE\tTIME\tTYPE\tPROJECT\tSOURCE\tTARGET\tTASK\tDESCRIPTION")
 
 	for _, a := range activities {
+		if showAuthor {
+			fmt.Fprintf(w, "%s\t", truncate(a.Author, 16))
+		}
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 			a.Date,
 			a.Time,
@@ -445,12 +742,12 @@ func outputCSV(activities []gitlab.ActivityEntry) error {
 	defer w.Flush()
 
 	// Header
-	if err := w.Write([]string{"date", "time", "type", "project", "source", "target", "task", "description"}); err != nil {
+	if err := w.Write([]string{"date", "time", "type", "project", "source", "target", "task", "description", "author"}); err != nil {
 		return err
 	}
 
 	for _, a := range activities {
-		if err := w.Write([]string{a.Date, a.Time, a.Type, a.Project, a.Source, a.Target, a.Task, a.Description}); err != nil {
+		if err := w.Write([]string{a.Date, a.Time, a.Type, a.Project, a.Source, a.Target, a.Task, a.Description, a.Author}); err != nil {
 			return err
 		}
 	}
@@ -464,8 +761,25 @@ func outputJSON(activities []gitlab.ActivityEntry) error {
 	return enc.Encode(activities)
 }
 
-func outputGroupedJSON(activities []gitlab.ActivityEntry) error {
-	// Group by date, then by task (same structure as outputGroupedTable)
+type activityTaskGroup struct {
+	Task       string                 `json:"task"`
+	Activities []gitlab.ActivityEntry `json:"activities"`
+}
+
+type activityDateGroup struct {
+	Date  string              `json:"date"`
+	Tasks []activityTaskGroup `json:"tasks"`
+}
+
+type activityUserGroup struct {
+	User       string                 `json:"user"`
+	Activities []gitlab.ActivityEntry `json:"activities,omitempty"`
+	Dates      []activityDateGroup    `json:"dates,omitempty"`
+}
+
+// groupByTask groups activities by date (newest first), then by task
+// (sorted, Unassigned last).
+func groupByTask(activities []gitlab.ActivityEntry) []activityDateGroup {
 	type groupKey struct {
 		date string
 		task string
@@ -495,17 +809,7 @@ func outputGroupedJSON(activities []gitlab.ActivityEntry) error {
 	}
 	sort.Sort(sort.Reverse(sort.StringSlice(sortedDates)))
 
-	// Build ordered output: Date -> Task -> Activities
-	type taskGroup struct {
-		Task       string                 `json:"task"`
-		Activities []gitlab.ActivityEntry `json:"activities"`
-	}
-	type dateGroup struct {
-		Date  string      `json:"date"`
-		Tasks []taskGroup `json:"tasks"`
-	}
-
-	result := make([]dateGroup, 0, len(sortedDates))
+	result := make([]activityDateGroup, 0, len(sortedDates))
 	for _, date := range sortedDates {
 		// Sort tasks for this date (Unassigned always last)
 		tasksForDate := tasksPerDate[date]
@@ -520,27 +824,64 @@ func outputGroupedJSON(activities []gitlab.ActivityEntry) error {
 			sortedTasks = append(sortedTasks, "Unassigned")
 		}
 
-		// Build task groups for this date
-		taskGroups := make([]taskGroup, 0, len(sortedTasks))
+		taskGroups := make([]activityTaskGroup, 0, len(sortedTasks))
 		for _, task := range sortedTasks {
-			key := groupKey{date: date, task: task}
-			if entries, ok := grouped[key]; ok && len(entries) > 0 {
-				taskGroups = append(taskGroups, taskGroup{
-					Task:       task,
-					Activities: entries,
-				})
-			}
+			taskGroups = append(taskGroups, activityTaskGroup{
+				Task:       task,
+				Activities: grouped[groupKey{date: date, task: task}],
+			})
 		}
 
-		result = append(result, dateGroup{
+		result = append(result, activityDateGroup{
 			Date:  date,
 			Tasks: taskGroups,
 		})
 	}
 
+	return result
+}
+
+// groupByUser groups activities by author, sorted by username. With byTask
+// each user's activities are further grouped as by groupByTask.
+func groupByUser(activities []gitlab.ActivityEntry, byTask bool) []activityUserGroup {
+	grouped := make(map[string][]gitlab.ActivityEntry)
+	for _, a := range activities {
+		user := a.Author
+		if user == "" {
+			user = "unknown"
+		}
+		grouped[user] = append(grouped[user], a)
+	}
+
+	users := make([]string, 0, len(grouped))
+	for u := range grouped {
+		users = append(users, u)
+	}
+	sort.Strings(users)
+
+	result := make([]activityUserGroup, 0, len(users))
+	for _, u := range users {
+		g := activityUserGroup{User: u}
+		if byTask {
+			g.Dates = groupByTask(grouped[u])
+		} else {
+			g.Activities = grouped[u]
+		}
+		result = append(result, g)
+	}
+	return result
+}
+
+func outputGroupedJSON(activities []gitlab.ActivityEntry) error {
 	enc := json.NewEncoder(os.Stdout)
 	enc.SetIndent("", "  ")
-	return enc.Encode(result)
+	return enc.Encode(groupByTask(activities))
+}
+
+func outputUserGroupedJSON(activities []gitlab.ActivityEntry, byTask bool) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(groupByUser(activities, byTask))
 }
 
 func outputGroupedTable(activities []gitlab.ActivityEntry, from, to string) error {
@@ -551,57 +892,54 @@ func outputGroupedTable(activities []gitlab.ActivityEntry, from, to string) erro
 		return nil
 	}
 
-	// Group by date, then by task
-	type groupKey struct {
-		date string
-		task string
-	}
-	grouped := make(map[groupKey][]gitlab.ActivityEntry)
-	dates := make(map[string]bool)
-	tasks := make(map[string]bool)
+	printDateGroups(groupByTask(activities), "")
+	return nil
+}
 
-	for _, a := range activities {
-		task := a.Task
-		if task == "" {
-			task = "Unassigned"
-		}
-		key := groupKey{date: a.Date, task: task}
-		grouped[key] = append(grouped[key], a)
-		dates[a.Date] = true
-		tasks[task] = true
+func outputUserGroupedTable(activities []gitlab.ActivityEntry, from, to string, byTask bool) error {
+	title := "User"
+	if byTask {
+		title = "User and Task"
 	}
+	fmt.Printf("Activity: %d events (%s to %s) - Grouped by %s\n\n", len(activities), from, to, title)
 
-	// Sort dates descending
-	sortedDates := make([]string, 0, len(dates))
-	for d := range dates {
-		sortedDates = append(sortedDates, d)
+	if len(activities) == 0 {
+		fmt.Println("No activities found")
+		return nil
 	}
-	sort.Sort(sort.Reverse(sort.StringSlice(sortedDates)))
 
-	// Sort tasks (Unassigned always last)
-	sortedTasks := make([]string, 0, len(tasks))
-	for t := range tasks {
-		if t != "Unassigned" {
-			sortedTasks = append(sortedTasks, t)
+	for _, g := range groupByUser(activities, byTask) {
+		if byTask {
+			fmt.Printf("%s:\n", g.User)
+			printDateGroups(g.Dates, "  ")
+			continue
 		}
-	}
-	sort.Strings(sortedTasks)
-	if tasks["Unassigned"] {
-		sortedTasks = append(sortedTasks, "Unassigned")
+		fmt.Printf("%s (%d events):\n", g.User, len(g.Activities))
+		for _, a := range g.Activities {
+			fmt.Printf("  - %s %s  %-12s  %-12s  %-10s  %s\n",
+				a.Date,
+				a.Time,
+				truncate(a.Type, 12),
+				truncate(a.Project, 12),
+				a.Task,
+				truncate(a.Description, 40),
+			)
+		}
+		fmt.Println()
 	}
 
-	// Output grouped
-	for _, date := range sortedDates {
-		fmt.Printf("%s:\n", date)
-		for _, task := range sortedTasks {
-			key := groupKey{date: date, task: task}
-			entries, ok := grouped[key]
-			if !ok || len(entries) == 0 {
-				continue
-			}
-			fmt.Printf("  %s:\n", task)
-			for _, a := range entries {
-				fmt.Printf("    - %s  %-12s  %-12s  %-18s  %s\n",
+	return nil
+}
+
+// printDateGroups prints date and task groups, each line prefixed by indent.
+func printDateGroups(groups []activityDateGroup, indent string) {
+	for _, d := range groups {
+		fmt.Printf("%s%s:\n", indent, d.Date)
+		for _, t := range d.Tasks {
+			fmt.Printf("%s  %s:\n", indent, t.Task)
+			for _, a := range t.Activities {
+				fmt.Printf("%s    - %s  %-12s  %-12s  %-18s  %s\n",
+					indent,
 					a.Time,
 					truncate(a.Type, 12),
 					truncate(a.Project, 12),
@@ -612,6 +950,4 @@ func outputGroupedTable(activities []gitlab.ActivityEntry, from, to string) erro
 		}
 		fmt.Println()
 	}
-
-	return nil
 }
@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+//go:embed templates/report.md.tmpl templates/report.html.tmpl
+var reportTemplates embed.FS
+
+// maxChartDays bounds the daily chart; longer ranges are cut off.
+const maxChartDays = 400
+
+// reportData is what report templates are executed with.
+type reportData struct {
+	Title     string
+	From      string
+	To        string
+	Generated string
+	Total     int
+	Tasks     []reportTask
+	Projects  []reportCount
+	Actions   []reportCount
+	Days      []reportCount
+	Chart     htmltemplate.HTML
+}
+
+type reportTask struct {
+	Task     string
+	Projects []string
+	Events   []reportEvent
+}
+
+type reportEvent struct {
+	gitlab.ActivityEntry
+	URL string
+}
+
+type reportCount struct {
+	Name  string
+	Count int
+}
+
+func runActivityReport(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if reportFormat != "markdown" && reportFormat != "html" {
+		return fmt.Errorf("invalid format '%s' (use markdown or html)", reportFormat)
+	}
+	tmpl, err := loadReportTemplate(reportFormat, reportTemplate)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	rng, err := activityRange(loc)
+	if err != nil {
+		return err
+	}
+
+	opts, err := activityEventOptions(cfg, client)
+	if err != nil {
+		return err
+	}
+
+	activities, _, err := loadActivities(cfg, client, opts, rng, loc, activityPipelines)
+	if err != nil {
+		return err
+	}
+
+	data := buildReport(activities, rng, time.Now().In(loc))
+	data.Title = reportTitle
+	if reportCharts {
+		data.Chart = htmltemplate.HTML(dailyChartSVG(data.Days))
+	}
+
+	return tmpl.Execute(os.Stdout, data)
+}
+
+// reportExecutor is implemented by both text and HTML templates.
+type reportExecutor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// loadReportTemplate returns the template for format: the file given by
+// override, a user template in ~/.gitlab-cli/templates, or the built-in one.
+func loadReportTemplate(format, override string) (reportExecutor, error) {
+	name := "report.md.tmpl"
+	if format == "html" {
+		name = "report.html.tmpl"
+	}
+
+	var src []byte
+	var err error
+	switch {
+	case override != "":
+		if src, err = os.ReadFile(override); err != nil {
+			return nil, fmt.Errorf("reading template: %w", err)
+		}
+	default:
+		if dir, derr := getCacheDir(); derr == nil {
+			src, err = os.ReadFile(filepath.Join(dir, "templates", name))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("reading template: %w", err)
+			}
+		}
+		if src == nil {
+			if src, err = reportTemplates.ReadFile("templates/" + name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	funcs := map[string]any{"md": escapeMarkdown}
+	if format == "html" {
+		t, err := htmltemplate.New(name).Funcs(funcs).Parse(string(src))
+		if err != nil {
+			return nil, fmt.Errorf("parsing template: %w", err)
+		}
+		return t, nil
+	}
+	t, err := template.New(name).Funcs(funcs).Parse(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	return t, nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "|", `\|`, "<", "&lt;",
+)
+
+// escapeMarkdown keeps text from being read as Markdown or HTML markup.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// buildReport groups the activities per task (sorted, Unassigned last) and
+// counts them per project, action and day.
+func buildReport(activities []gitlab.ActivityEntry, rng timerange.Range, now time.Time) reportData {
+	data := reportData{
+		From:      rng.FromDate(),
+		To:        rng.ToDate(),
+		Generated: now.Format("2006-01-02 15:04 MST"),
+		Total:     len(activities),
+	}
+
+	byTask := make(map[string]*reportTask)
+	projects := make(map[string]int)
+	actions := make(map[string]int)
+	days := make(map[string]int)
+
+	for _, a := range activities {
+		name := a.Task
+		if name == "" {
+			name = "Unassigned"
+		}
+		t := byTask[name]
+		if t == nil {
+			t = &reportTask{Task: name}
+			byTask[name] = t
+		}
+		url, _ := a.Details["web_url"].(string)
+		t.Events = append(t.Events, reportEvent{ActivityEntry: a, URL: url})
+		if a.Project != "" && !slices.Contains(t.Projects, a.Project) {
+			t.Projects = append(t.Projects, a.Project)
+		}
+
+		projects[a.Project]++
+		actions[a.Type]++
+		days[a.Date]++
+	}
+
+	for _, t := range byTask {
+		sort.Strings(t.Projects)
+		data.Tasks = append(data.Tasks, *t)
+	}
+	sort.Slice(data.Tasks, func(i, j int) bool {
+		a, b := data.Tasks[i].Task, data.Tasks[j].Task
+		if (a == "Unassigned") != (b == "Unassigned") {
+			return b == "Unassigned"
+		}
+		return a < b
+	})
+
+	data.Projects = sortedCounts(projects)
+	data.Actions = sortedCounts(actions)
+	data.Days = dailyCounts(days, data.From, data.To)
+
+	return data
+}
+
+// sortedCounts orders counts by count descending, then by name.
+func sortedCounts(counts map[string]int) []reportCount {
+	result := make([]reportCount, 0, len(counts))
+	for name, n := range counts {
+		result = append(result, reportCount{Name: name, Count: n})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// dailyCounts returns one count per day from from to to (YYYY-MM-DD),
+// including days without events. Open bounds are taken from the data.
+func dailyCounts(days map[string]int, from, to string) []reportCount {
+	for d := range days {
+		if from == "" || d < from {
+			from = d
+		}
+		if to == "" || d > to {
+			to = d
+		}
+	}
+	start, err1 := time.Parse("2006-01-02", from)
+	end, err2 := time.Parse("2006-01-02", to)
+	if err1 != nil || err2 != nil || end.Before(start) {
+		return nil
+	}
+
+	var result []reportCount
+	for d := start; !d.After(end) && len(result) < maxChartDays; d = d.AddDate(0, 0, 1) {
+		date := d.Format("2006-01-02")
+		result = append(result, reportCount{Name: date, Count: days[date]})
+	}
+	return result
+}
+
+// dailyChartSVG draws a bar chart of daily counts with the first and last
+// day as labels and a tooltip per bar.
+func dailyChartSVG(days []reportCount) string {
+	if len(days) == 0 {
+		return ""
+	}
+
+	const (
+		barWidth = 12
+		barGap   = 2
+		height   = 100
+		top      = 16
+		bottom   = 18
+	)
+	peak := 0
+	for _, d := range days {
+		if d.Count > peak {
+			peak = d.Count
+		}
+	}
+	width := len(days) * (barWidth + barGap)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="10">`,
+		width, height+top+bottom, width, height+top+bottom)
+	fmt.Fprintf(&b, `<text x="0" y="10" fill="#555">max %d/day</text>`, peak)
+	for i, d := range days {
+		h := 0
+		if peak > 0 {
+			h = d.Count * height / peak
+		}
+		if d.Count > 0 && h < 1 {
+			h = 1
+		}
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" fill="#1f75cb"><title>%s: %d</title></rect>`,
+			i*(barWidth+barGap), top+height-h, barWidth, h, d.Name, d.Count)
+	}
+	fmt.Fprintf(&b, `<line x1="0" y1="%d" x2="%d" y2="%d" stroke="#999"/>`, top+height, width, top+height)
+	fmt.Fprintf(&b, `<text x="0" y="%d" fill="#555">%s</text>`, top+height+bottom-4, days[0].Name)
+	if len(days) > 1 {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="#555" text-anchor="end">%s</text>`, width, top+height+bottom-4, days[len(days)-1].Name)
+	}
+	b.WriteString(`</svg>`)
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+func testReport() reportData {
+	activities := []gitlab.ActivityEntry{
+		{Date: "2024-05-03", Time: "10:00", Type: "opened", Project: "api", Task: "#12345", Description: "MR !7: Add *cache*",
+			Details: map[string]interface{}{"web_url": "https://gitlab.example.com/acme/api/-/merge_requests/7"}},
+		{Date: "2024-05-03", Time: "09:00", Type: "pushed to", Project: "web", Description: "2 commit(s)"},
+		{Date: "2024-05-01", Time: "11:00", Type: "pushed to", Project: "api", Task: "#12345", Description: "1 commit(s)"},
+	}
+	rng, _ := timerange.Parse("2024-05-01", time.UTC)
+	rng.To = rng.To.AddDate(0, 0, 3)
+	return buildReport(activities, rng, time.Date(2024, 5, 4, 8, 0, 0, 0, time.UTC))
+}
+
+func TestBuildReport(t *testing.T) {
+	data := testReport()
+
+	if data.From != "2024-05-01" || data.To != "2024-05-04" || data.Total != 3 {
+		t.Errorf("unexpected header: %s..%s, %d events", data.From, data.To, data.Total)
+	}
+	if len(data.Tasks) != 2 || data.Tasks[0].Task != "#12345" || data.Tasks[1].Task != "Unassigned" {
+		t.Fatalf("unexpected tasks: %+v", data.Tasks)
+	}
+	if data.Tasks[0].Events[0].URL == "" || len(data.Tasks[0].Events) != 2 {
+		t.Errorf("expected 2 events with the MR link first, got %+v", data.Tasks[0].Events)
+	}
+	if data.Projects[0] != (reportCount{"api", 2}) || data.Actions[0] != (reportCount{"pushed to", 2}) {
+		t.Errorf("unexpected counts: %+v %+v", data.Projects, data.Actions)
+	}
+
+	var counts []int
+	for _, d := range data.Days {
+		counts = append(counts, d.Count)
+	}
+	if len(counts) != 4 || counts[0] != 1 || counts[1] != 0 || counts[2] != 2 || counts[3] != 0 {
+		t.Errorf("daily counts = %v, want [1 0 2 0]", counts)
+	}
+}
+
+func TestReportTemplates(t *testing.T) {
+	cacheDir = t.TempDir()
+	defer func() { cacheDir = "" }()
+
+	data := testReport()
+	data.Title = "Weekly status"
+	data.Chart = htmltemplate.HTML(dailyChartSVG(data.Days))
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"markdown", []string{
+			"# Weekly status",
+			"## #12345",
+			"[MR !7: Add \\*cache\\*](https://gitlab.example.com/acme/api/-/merge_requests/7)",
+			"| api | 2 |",
+			"<svg",
+		}},
+		{"html", []string{
+			"<h1>Weekly status</h1>",
+			`<a href="https://gitlab.example.com/acme/api/-/merge_requests/7">MR !7: Add *cache*</a>`,
+			`<td>api</td><td class="count">2</td>`,
+			"<svg",
+		}},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := loadReportTemplate(tt.format, "")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%s output is missing %q:\n%s", tt.format, want, buf.String())
+			}
+		}
+	}
+}
+
+func TestDailyChartSVG(t *testing.T) {
+	svg := dailyChartSVG([]reportCount{{"2024-05-01", 4}, {"2024-05-02", 0}, {"2024-05-03", 2}})
+
+	if strings.Count(svg, "<rect") != 3 {
+		t.Errorf("expected 3 bars:\n%s", svg)
+	}
+	for _, want := range []string{`height="100" fill`, `height="50" fill`, "<title>2024-05-02: 0</title>", "max 4/day"} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("missing %q in:\n%s", want, svg)
+		}
+	}
+	if dailyChartSVG(nil) != "" {
+		t.Error("expected no chart without days")
+	}
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/store"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+// firstSyncMonths is how far back the first sync reaches without --from.
+const firstSyncMonths = 3
+
+// openActivityStore opens the store of the configured GitLab instance.
+func openActivityStore(cfg *config.Config) (*store.Store, error) {
+	dir, err := getCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	host := cfg.GitLabURL
+	if u, err := url.Parse(cfg.GitLabURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	host = strings.NewReplacer(":", "_", "/", "_").Replace(host)
+	return store.Open(filepath.Join(dir, "activity", host))
+}
+
+// loadActivities returns the activities of rng from GitLab, or with
+// --offline from the local store.
+func loadActivities(cfg *config.Config, client *gitlab.Client, opts gitlab.ListEventsOptions, rng timerange.Range, loc *time.Location, pipelines bool) ([]gitlab.ActivityEntry, map[string]*gitlab.MergeRequest, error) {
+	if !activityOffline {
+		return collectActivities(client, taskMatcher(cfg), opts, rng, loc, pipelines)
+	}
+
+	if len(opts.Users) > 0 || opts.ProjectID != "" {
+		return nil, nil, fmt.Errorf("--offline covers only your own activity and cannot be combined with --user, --team or --project")
+	}
+	if pipelines {
+		return nil, nil, fmt.Errorf("--offline cannot be combined with --pipelines")
+	}
+
+	s, err := openActivityStore(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	st, err := s.State()
+	if err != nil {
+		return nil, nil, err
+	}
+	if st.SyncedAt.IsZero() {
+		return nil, nil, fmt.Errorf("the activity store is empty; run 'gitlab-cli activity sync' first")
+	}
+	if rng.To.IsZero() || rng.To.After(st.SyncedAt) {
+		fmt.Fprintf(os.Stderr, "Note: activity store last synced %s\n", st.SyncedAt.In(loc).Format("2006-01-02 15:04"))
+	}
+
+	records, err := s.Query(rng.From, rng.To)
+	if err != nil {
+		return nil, nil, err
+	}
+	activities := make([]gitlab.ActivityEntry, len(records))
+	for i, r := range records {
+		// Dates are stored as synced; show them in the current zone
+		t := r.CreatedAt.In(loc)
+		activities[i] = r.Entry
+		activities[i].Date = t.Format("2006-01-02")
+		activities[i].Time = t.Format("15:04")
+	}
+
+	return activities, map[string]*gitlab.MergeRequest{}, nil
+}
+
+func runActivitySync(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	s, err := openActivityStore(cfg)
+	if err != nil {
+		return err
+	}
+	st, err := s.State()
+	if err != nil {
+		return err
+	}
+
+	// The cursor is the newest stored event. The API filters by date, so
+	// refetch from the day before it and skip what is already stored.
+	var from time.Time
+	switch {
+	case st.LastEventID > 0:
+		from = st.LastEventAt
+	case syncFrom != "":
+		r, err := timerange.Parse(syncFrom, loc)
+		if err != nil {
+			return fmt.Errorf("--from: %w", err)
+		}
+		from = r.From
+	default:
+		from = timerange.Day(time.Now().In(loc).AddDate(0, -firstSyncMonths, 0)).From
+	}
+	after, _ := timerange.Range{From: from}.APIBounds()
+
+	fetched, err := client.GetEvents(gitlab.ListEventsOptions{After: after})
+	if err != nil {
+		return err
+	}
+
+	var events []gitlab.Event
+	var times []time.Time
+	for _, e := range fetched {
+		t, err := time.Parse(time.RFC3339, e.CreatedAt)
+		if err != nil || e.ID <= st.LastEventID || t.Before(from) {
+			continue
+		}
+		events = append(events, e)
+		times = append(times, t)
+	}
+
+	if dryRun {
+		fmt.Printf("Would sync %d new events\n", len(events))
+		return nil
+	}
+
+	entries, _, _ := enrichEvents(client, taskMatcher(cfg), events, loc)
+	records := make([]store.Record, len(events))
+	next := st
+	for i, e := range events {
+		records[i] = store.Record{EventID: e.ID, CreatedAt: times[i].UTC(), Entry: entries[i]}
+		if e.ID > next.LastEventID {
+			next.LastEventID = e.ID
+		}
+		if times[i].After(next.LastEventAt) {
+			next.LastEventAt = times[i].UTC()
+		}
+	}
+
+	added, err := s.Add(records)
+	if err != nil {
+		return err
+	}
+	next.SyncedAt = time.Now().UTC()
+	if err := s.SaveState(next); err != nil {
+		return err
+	}
+
+	count, oldest, err := s.Count()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Synced %d new events; the store holds %d", added, count)
+	if count > 0 {
+		fmt.Printf(" since %s", oldest.In(loc).Format("2006-01-02"))
+	}
+	fmt.Println()
+	return nil
+}
+
+func runActivityPrune(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	var before time.Time
+	switch {
+	case pruneBefore != "" && pruneOlderThan != "":
+		return fmt.Errorf("use either --before or --older-than")
+	case pruneBefore != "":
+		r, err := timerange.Parse(pruneBefore, loc)
+		if err != nil {
+			return fmt.Errorf("--before: %w", err)
+		}
+		before = r.From
+	case pruneOlderThan != "":
+		r, err := timerange.Since(time.Now().In(loc), pruneOlderThan)
+		if err != nil {
+			return fmt.Errorf("--older-than: %w", err)
+		}
+		before = r.From
+	default:
+		return fmt.Errorf("--before or --older-than is required")
+	}
+
+	s, err := openActivityStore(cfg)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		records, err := s.Query(time.Time{}, before)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Would remove %d events before %s\n", len(records), before.Format("2006-01-02"))
+		return nil
+	}
+
+	removed, err := s.Prune(before)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Removed %d events before %s\n", removed, before.Format("2006-01-02"))
+	return nil
+}
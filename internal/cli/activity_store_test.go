@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/store"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+func TestLoadActivitiesOffline(t *testing.T) {
+	cacheDir = t.TempDir()
+	activityOffline = true
+	defer func() {
+		cacheDir = ""
+		activityOffline = false
+	}()
+
+	cfg := &config.Config{GitLabURL: "https://gitlab.example.com:8443"}
+	s, err := openActivityStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Dir() != filepath.Join(cacheDir, "activity", "gitlab.example.com_8443") {
+		t.Errorf("unexpected store directory %s", s.Dir())
+	}
+
+	if _, _, err := loadActivities(cfg, nil, gitlab.ListEventsOptions{}, timerange.Range{}, time.UTC, false); err == nil {
+		t.Error("expected error before the first sync")
+	}
+
+	s.Add([]store.Record{
+		{EventID: 1, CreatedAt: time.Date(2024, 5, 1, 22, 30, 0, 0, time.UTC), Entry: gitlab.ActivityEntry{Date: "2024-05-01", Time: "22:30", Task: "#12345"}},
+		{EventID: 2, CreatedAt: time.Date(2024, 5, 3, 8, 0, 0, 0, time.UTC), Entry: gitlab.ActivityEntry{Date: "2024-05-03", Time: "08:00"}},
+	})
+	s.SaveState(store.State{LastEventID: 2, SyncedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)})
+
+	loc := time.FixedZone("UTC+2", 2*3600)
+	rng, _ := timerange.Parse("2024-05-02", loc)
+	activities, _, err := loadActivities(cfg, nil, gitlab.ListEventsOptions{}, rng, loc, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(activities) != 1 || activities[0].Task != "#12345" || activities[0].Date != "2024-05-02" || activities[0].Time != "00:30" {
+		t.Errorf("unexpected activities: %+v", activities)
+	}
+
+	if _, _, err := loadActivities(cfg, nil, gitlab.ListEventsOptions{Users: []string{"alice"}}, rng, loc, false); err == nil {
+		t.Error("expected error for --user with --offline")
+	}
+}
+
+func TestRunActivitySyncDryRun(t *testing.T) {
+	cacheDir = t.TempDir()
+	dryRun = true
+	defer func() { cacheDir, cfgFile, dryRun = "", "", false }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/events" || r.URL.Query().Get("page") != "1" {
+			w.Write([]byte("[]"))
+			return
+		}
+		json.NewEncoder(w).Encode([]gitlab.Event{{ID: 1, ActionName: "pushed to", CreatedAt: time.Now().UTC().Format(time.RFC3339)}})
+	}))
+	defer srv.Close()
+
+	cfgFile = filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgFile, []byte("gitlab_url: "+srv.URL+"\ngitlab_token: token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runActivitySync(activitySyncCmd, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := openActivityStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := s.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count, _, _ := s.Count(); count != 0 || !st.SyncedAt.IsZero() {
+		t.Errorf("dry run stored %d events, synced at %v", count, st.SyncedAt)
+	}
+}
+
+func TestRunActivityTimesheetOfflineTimeStats(t *testing.T) {
+	activityOffline, timesheetTimeStats = true, true
+	defer func() { activityOffline, timesheetTimeStats, cfgFile = false, false, "" }()
+
+	cfgFile = filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgFile, []byte("gitlab_url: https://gitlab.example.com\ngitlab_token: token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runActivityTimesheet(activityTimesheetCmd, nil); err == nil || !strings.Contains(err.Error(), "--offline") {
+		t.Errorf("expected --offline --time-stats to be rejected, got %v", err)
+	}
+}
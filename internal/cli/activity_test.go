@@ -2,8 +2,10 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 func TestExtractTaskFromString(t *testing.T) {
@@ -21,9 +23,9 @@ func TestExtractTaskFromString(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := extractTaskFromString(tt.input)
+			result := task.DefaultMatcher().FromText("", tt.input)
 			if result != tt.expected {
-				t.Errorf("extractTaskFromString(%q) = %q, want %q", tt.input, result, tt.expected)
+				t.Errorf("task.DefaultMatcher().FromText(%q) = %q, want %q", tt.input, result, tt.expected)
 			}
 		})
 	}
@@ -44,9 +46,9 @@ func TestExtractTaskFromBranch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := extractTaskFromBranch(tt.input)
+			result := task.DefaultMatcher().FromBranch("", tt.input)
 			if result != tt.expected {
-				t.Errorf("extractTaskFromBranch(%q) = %q, want %q", tt.input, result, tt.expected)
+				t.Errorf("task.DefaultMatcher().FromBranch(%q) = %q, want %q", tt.input, result, tt.expected)
 			}
 		})
 	}
@@ -74,7 +76,7 @@ func TestTransformEvent_NoteTargetTitleFallback(t *testing.T) {
 	mrCache := make(map[string]*gitlab.MergeRequest)
 	// No client - MR lookup will fail, forcing fallback
 
-	entry := transformEvent(event, projectCache, defaultBranchCache, mrCache, nil)
+	entry := transformEvent(event, time.UTC, projectCache, nil, defaultBranchCache, mrCache, nil, task.DefaultMatcher())
 
 	if entry.Task != "#50607" {
 		t.Errorf("expected task #50607 from TargetTitle fallback, got %q", entry.Task)
@@ -100,7 +102,7 @@ func TestTransformEvent_IssueCommentTargetTitleFallback(t *testing.T) {
 	defaultBranchCache := map[int]string{100: "main"}
 	mrCache := make(map[string]*gitlab.MergeRequest)
 
-	entry := transformEvent(event, projectCache, defaultBranchCache, mrCache, nil)
+	entry := transformEvent(event, time.UTC, projectCache, nil, defaultBranchCache, mrCache, nil, task.DefaultMatcher())
 
 	if entry.Task != "#51234" {
 		t.Errorf("expected task #51234 from Issue TargetTitle, got %q", entry.Task)
@@ -151,9 +153,9 @@ func TestPipelineActivityEntry(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			task := extractTaskFromBranch(tt.pipeline.Ref)
+			task := task.DefaultMatcher().FromBranch("", tt.pipeline.Ref)
 			if task != tt.expectedTask {
-				t.Errorf("extractTaskFromBranch(%q) = %q, want %q", tt.pipeline.Ref, task, tt.expectedTask)
+				t.Errorf("task.DefaultMatcher().FromBranch(%q) = %q, want %q", tt.pipeline.Ref, task, tt.expectedTask)
 			}
 
 			description := "pipeline " + tt.pipeline.Status
@@ -187,3 +189,92 @@ func TestPipelineInfoStruct(t *testing.T) {
 		t.Errorf("PipelineInfo.CreatedAt = %s, want 2025-12-21T14:30:00.000Z", p.CreatedAt)
 	}
 }
+
+func TestGroupByUser(t *testing.T) {
+	activities := []gitlab.ActivityEntry{
+		{Date: "2024-05-02", Time: "10:00", Author: "bob", Task: "#12345"},
+		{Date: "2024-05-02", Time: "09:00", Author: "alice"},
+		{Date: "2024-05-01", Time: "11:00", Author: "bob", Task: "#22222"},
+		{Date: "2024-05-01", Time: "10:00", Author: "bob", Task: "#12345"},
+	}
+
+	groups := groupByUser(activities, false)
+	if len(groups) != 2 || groups[0].User != "alice" || groups[1].User != "bob" {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+	if len(groups[1].Activities) != 3 || groups[1].Dates != nil {
+		t.Errorf("expected 3 ungrouped activities for bob, got %+v", groups[1])
+	}
+
+	groups = groupByUser(activities, true)
+	bob := groups[1]
+	if len(bob.Dates) != 2 || bob.Dates[0].Date != "2024-05-02" {
+		t.Fatalf("unexpected dates for bob: %+v", bob.Dates)
+	}
+	if tasks := bob.Dates[1].Tasks; len(tasks) != 2 || tasks[0].Task != "#12345" || tasks[1].Task != "#22222" {
+		t.Errorf("unexpected tasks on 2024-05-01: %+v", tasks)
+	}
+	if groups[0].Dates[0].Tasks[0].Task != "Unassigned" {
+		t.Errorf("expected Unassigned task for alice, got %+v", groups[0].Dates)
+	}
+}
+
+func TestTransformEventTimezone(t *testing.T) {
+	event := gitlab.Event{
+		ActionName:  "opened",
+		CreatedAt:   "2024-05-01T22:30:00Z",
+		TargetType:  "Issue",
+		TargetIID:   1,
+		TargetTitle: "Late issue",
+	}
+	loc := time.FixedZone("UTC+2", 2*3600)
+
+	entry := transformEvent(event, loc, map[int]string{}, nil, map[int]string{}, map[string]*gitlab.MergeRequest{}, nil, task.DefaultMatcher())
+
+	if entry.Date != "2024-05-02" || entry.Time != "00:30" {
+		t.Errorf("expected 2024-05-02 00:30, got %s %s", entry.Date, entry.Time)
+	}
+}
+
+func TestActivityRangeFlags(t *testing.T) {
+	defer func() {
+		activityFrom, activityTo, activitySince = "", "", ""
+	}()
+
+	activityFrom, activityTo = "2024-W18", "2024-05-10"
+	r, err := activityRange(time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.FromDate() != "2024-04-29" || r.ToDate() != "2024-05-10" {
+		t.Errorf("got %s..%s, want 2024-04-29..2024-05-10", r.FromDate(), r.ToDate())
+	}
+
+	activitySince = "2w"
+	if _, err := activityRange(time.UTC); err == nil {
+		t.Error("expected error when combining --from and --since")
+	}
+}
+
+func TestEventURL(t *testing.T) {
+	base := "https://gitlab.example.com/acme/api"
+	tests := []struct {
+		event gitlab.Event
+		want  string
+	}{
+		{gitlab.Event{TargetType: "MergeRequest", TargetIID: 7}, base + "/-/merge_requests/7"},
+		{gitlab.Event{TargetType: "DiffNote", TargetIID: 991, Note: &gitlab.NoteData{NoteableType: "MergeRequest", NoteableIID: 7}}, base + "/-/merge_requests/7"},
+		{gitlab.Event{TargetType: "Issue", TargetIID: 3}, base + "/-/issues/3"},
+		{gitlab.Event{PushData: &gitlab.PushData{Ref: "main", CommitTo: "abc123"}}, base + "/-/commit/abc123"},
+		{gitlab.Event{PushData: &gitlab.PushData{Ref: "old", Action: "removed"}}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := eventURL(tt.event, base); got != tt.want {
+			t.Errorf("eventURL(%+v) = %q, want %q", tt.event, got, tt.want)
+		}
+	}
+	if got := eventURL(tests[0].event, ""); got != "" {
+		t.Errorf("expected no URL without project URL, got %q", got)
+	}
+}
@@ -0,0 +1,416 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+// timesheetOptions is the session heuristic of activity timesheet.
+type timesheetOptions struct {
+	Gap        time.Duration  // a longer pause starts a new session
+	FirstEvent time.Duration  // work credited before the first event of a session
+	Round      time.Duration  // rounding unit of daily totals; 0 disables
+	Rounding   string         // up, nearest or down
+	Location   *time.Location // zone of the activity dates; nil means UTC
+}
+
+// workBlock is a stretch of uninterrupted work on one task of one project.
+// It runs from the previous event of the session (or FirstEvent before the
+// session's first event) to the last event of the block.
+type workBlock struct {
+	Date    string
+	Task    string
+	Project string
+	Start   time.Time
+	End     time.Time
+	Events  int
+}
+
+// timesheetRow is the time spent on a task of a project on one day.
+type timesheetRow struct {
+	Date       string `json:"date"`
+	Task       string `json:"task"`
+	Project    string `json:"project"`
+	Blocks     int    `json:"blocks"`
+	Minutes    int    `json:"minutes"`
+	RawMinutes int    `json:"raw_minutes"`
+}
+
+// timesheetTotal sums rounded minutes by project or task. Logged and
+// Estimate are the GitLab time tracking of the task's MRs.
+type timesheetTotal struct {
+	Name            string `json:"name"`
+	Minutes         int    `json:"minutes"`
+	LoggedMinutes   int    `json:"logged_minutes,omitempty"`
+	EstimateMinutes int    `json:"estimate_minutes,omitempty"`
+}
+
+func runActivityTimesheet(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	opts := timesheetOptions{
+		Gap:        cfg.TimesheetGap,
+		FirstEvent: cfg.TimesheetFirstEvent,
+		Round:      cfg.TimesheetRound,
+		Rounding:   cfg.TimesheetRounding,
+	}
+	if cmd.Flags().Changed("gap") {
+		opts.Gap = timesheetGap
+	}
+	if cmd.Flags().Changed("first-event") {
+		opts.FirstEvent = timesheetFirstEvent
+	}
+	if cmd.Flags().Changed("round") {
+		opts.Round = timesheetRound
+	}
+	if cmd.Flags().Changed("rounding") {
+		opts.Rounding = timesheetRounding
+	}
+	switch opts.Rounding {
+	case "up", "nearest", "down":
+	default:
+		return fmt.Errorf("invalid rounding '%s' (use up, nearest or down)", opts.Rounding)
+	}
+	if opts.Gap <= 0 {
+		return fmt.Errorf("--gap must be positive")
+	}
+
+	switch timesheetFormat {
+	case "table", "csv", "json", "ical":
+	default:
+		return fmt.Errorf("invalid format '%s' (use table, csv, json or ical)", timesheetFormat)
+	}
+
+	if activityOffline && timesheetTimeStats {
+		return fmt.Errorf("--time-stats reads the time tracking of MRs from GitLab and cannot be combined with --offline")
+	}
+
+	client := newClient(cfg)
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+	opts.Location = loc
+
+	rng, err := activityRange(loc)
+	if err != nil {
+		return err
+	}
+	fromDate, toDate := rng.FromDate(), rng.ToDate()
+
+	activities, mrs, err := loadActivities(cfg, client, gitlab.ListEventsOptions{}, rng, loc, activityPipelines)
+	if err != nil {
+		return err
+	}
+
+	blocks := buildWorkBlocks(activities, opts)
+	rows := buildTimesheet(blocks, opts)
+	projects := timesheetTotals(rows, func(r timesheetRow) string { return r.Project })
+	taskTotals := timesheetTotals(rows, func(r timesheetRow) string { return r.Task })
+	if timesheetTimeStats {
+		addLoggedTime(taskMatcher(cfg), taskTotals, mrs)
+	}
+
+	switch timesheetFormat {
+	case "csv":
+		return writeTimesheetCSV(os.Stdout, rows)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			From     string           `json:"from"`
+			To       string           `json:"to"`
+			Rows     []timesheetRow   `json:"rows"`
+			Projects []timesheetTotal `json:"projects"`
+			Tasks    []timesheetTotal `json:"tasks"`
+		}{fromDate, toDate, rows, projects, taskTotals})
+	case "ical":
+		return writeTimesheetICal(os.Stdout, blocks, time.Now())
+	}
+
+	fmt.Printf("Timesheet: %s to %s (session gap %s, first event %s, %s)\n\n",
+		fromDate, toDate, opts.Gap, opts.FirstEvent, describeRounding(opts))
+
+	if len(rows) == 0 {
+		fmt.Println("No activities found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tTASK\tPROJECT\tBLOCKS\tHOURS")
+	total := 0
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", r.Date, r.Task, truncate(r.Project, 20), r.Blocks, formatHours(r.Minutes))
+		total += r.Minutes
+	}
+	w.Flush()
+	fmt.Printf("\nTotal: %sh\n", formatHours(total))
+
+	fmt.Println("\nBy project:")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECT\tHOURS")
+	for _, p := range projects {
+		fmt.Fprintf(w, "%s\t%s\n", p.Name, formatHours(p.Minutes))
+	}
+	w.Flush()
+
+	if timesheetTimeStats {
+		fmt.Println("\nBy task:")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TASK\tHOURS\tLOGGED\tESTIMATE")
+		for _, t := range taskTotals {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Name, formatHours(t.Minutes), formatHours(t.LoggedMinutes), formatHours(t.EstimateMinutes))
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+// buildWorkBlocks splits the activities into work blocks. Events are taken
+// in time order; a pause longer than Gap or a new day starts a new session,
+// and within a session the time since the previous event goes to the task of
+// the current event.
+func buildWorkBlocks(activities []gitlab.ActivityEntry, opts timesheetOptions) []workBlock {
+	type timedEntry struct {
+		at time.Time
+		gitlab.ActivityEntry
+	}
+
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	entries := make([]timedEntry, 0, len(activities))
+	for _, a := range activities {
+		at, err := time.ParseInLocation("2006-01-02 15:04", a.Date+" "+a.Time, loc)
+		if err != nil {
+			continue
+		}
+		if a.Task == "" {
+			a.Task = "Unassigned"
+		}
+		entries = append(entries, timedEntry{at, a})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].at.Before(entries[j].at) })
+
+	var blocks []workBlock
+	var last time.Time
+	for _, e := range entries {
+		newSession := len(blocks) == 0 || e.Date != blocks[len(blocks)-1].Date || e.at.Sub(last) > opts.Gap
+		if !newSession {
+			cur := &blocks[len(blocks)-1]
+			if cur.Task == e.Task && cur.Project == e.Project {
+				cur.End = e.at
+				cur.Events++
+				last = e.at
+				continue
+			}
+		}
+
+		start := last
+		if newSession {
+			start = e.at.Add(-opts.FirstEvent)
+			if dayStart := timerange.Day(e.at).From; start.Before(dayStart) {
+				start = dayStart
+			}
+		}
+		blocks = append(blocks, workBlock{
+			Date:    e.Date,
+			Task:    e.Task,
+			Project: e.Project,
+			Start:   start,
+			End:     e.at,
+			Events:  1,
+		})
+		last = e.at
+	}
+	return blocks
+}
+
+// buildTimesheet sums the blocks per day, task and project and rounds the
+// sums. Rows are ordered by date, then task (Unassigned last), then project.
+func buildTimesheet(blocks []workBlock, opts timesheetOptions) []timesheetRow {
+	type rowKey struct{ date, task, project string }
+	sums := make(map[rowKey]time.Duration)
+	counts := make(map[rowKey]int)
+	for _, b := range blocks {
+		key := rowKey{b.Date, b.Task, b.Project}
+		sums[key] += b.End.Sub(b.Start)
+		counts[key]++
+	}
+
+	rows := make([]timesheetRow, 0, len(sums))
+	for key, d := range sums {
+		rows = append(rows, timesheetRow{
+			Date:       key.date,
+			Task:       key.task,
+			Project:    key.project,
+			Blocks:     counts[key],
+			Minutes:    int(roundDuration(d, opts.Round, opts.Rounding) / time.Minute),
+			RawMinutes: int(d / time.Minute),
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Task != b.Task {
+			return taskLess(a.Task, b.Task)
+		}
+		return a.Project < b.Project
+	})
+	return rows
+}
+
+// taskLess orders tasks alphabetically with Unassigned last.
+func taskLess(a, b string) bool {
+	if (a == "Unassigned") != (b == "Unassigned") {
+		return b == "Unassigned"
+	}
+	return a < b
+}
+
+// timesheetTotals sums rounded minutes by the name key returns, largest
+// first.
+func timesheetTotals(rows []timesheetRow, key func(timesheetRow) string) []timesheetTotal {
+	sums := make(map[string]int)
+	for _, r := range rows {
+		sums[key(r)] += r.Minutes
+	}
+
+	totals := make([]timesheetTotal, 0, len(sums))
+	for name, minutes := range sums {
+		totals = append(totals, timesheetTotal{Name: name, Minutes: minutes})
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Minutes != totals[j].Minutes {
+			return totals[i].Minutes > totals[j].Minutes
+		}
+		return totals[i].Name < totals[j].Name
+	})
+	return totals
+}
+
+// addLoggedTime adds the GitLab time tracking of the MRs seen in the
+// activity to the totals of their tasks.
+func addLoggedTime(tasks *task.Matcher, totals []timesheetTotal, mrs map[string]*gitlab.MergeRequest) {
+	for _, mr := range mrs {
+		if mr == nil || mr.TimeStats == nil {
+			continue
+		}
+		task := tasks.FromBranch(mrProjectPath(mr), mr.SourceBranch)
+		if task == "" {
+			task = tasks.FromText(mrProjectPath(mr), mr.Title)
+		}
+		for i := range totals {
+			if totals[i].Name == task {
+				totals[i].LoggedMinutes += mr.TimeStats.TotalTimeSpent / 60
+				totals[i].EstimateMinutes += mr.TimeStats.TimeEstimate / 60
+			}
+		}
+	}
+}
+
+func roundDuration(d, unit time.Duration, mode string) time.Duration {
+	if unit <= 0 {
+		return d
+	}
+	switch mode {
+	case "down":
+		return d.Truncate(unit)
+	case "nearest":
+		return d.Round(unit)
+	default:
+		return (d + unit - 1).Truncate(unit)
+	}
+}
+
+func describeRounding(opts timesheetOptions) string {
+	if opts.Round <= 0 {
+		return "no rounding"
+	}
+	if opts.Rounding == "nearest" {
+		return "rounded to nearest " + opts.Round.String()
+	}
+	return "rounded " + opts.Rounding + " to " + opts.Round.String()
+}
+
+func formatHours(minutes int) string {
+	return strconv.FormatFloat(float64(minutes)/60, 'f', 2, 64)
+}
+
+func writeTimesheetCSV(out io.Writer, rows []timesheetRow) error {
+	w := csv.NewWriter(out)
+	defer w.Flush()
+
+	if err := w.Write([]string{"date", "task", "project", "blocks", "minutes", "hours"}); err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		if err := w.Write([]string{r.Date, r.Task, r.Project, strconv.Itoa(r.Blocks), strconv.Itoa(r.Minutes), formatHours(r.Minutes)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeTimesheetICal writes one VEVENT per work block. Blocks are written
+// as measured; rounding only applies to the daily totals.
+func writeTimesheetICal(out io.Writer, blocks []workBlock, now time.Time) error {
+	const stamp = "20060102T150405Z"
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//gitlab-cli//activity timesheet//EN\r\n")
+	for i, blk := range blocks {
+		summary := blk.Task
+		if blk.Project != "" {
+			summary += " (" + blk.Project + ")"
+		}
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%s-%d@gitlab-cli\r\n", blk.Start.UTC().Format(stamp), i)
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", now.UTC().Format(stamp))
+		fmt.Fprintf(&b, "DTSTART:%s\r\n", blk.Start.UTC().Format(stamp))
+		fmt.Fprintf(&b, "DTEND:%s\r\n", blk.End.UTC().Format(stamp))
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", escapeICalText(summary))
+		fmt.Fprintf(&b, "DESCRIPTION:%d event(s)\r\n", blk.Events)
+		b.WriteString("END:VEVENT\r\n")
+	}
+	b.WriteString("END:VCALENDAR\r\n")
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
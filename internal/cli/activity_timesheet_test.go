@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func testTimesheetActivities() []gitlab.ActivityEntry {
+	// Newest first, like the events API returns them
+	return []gitlab.ActivityEntry{
+		{Date: "2024-05-02", Time: "09:10", Task: "#12345", Project: "api"},
+		{Date: "2024-05-01", Time: "14:00", Task: "", Project: "api"},
+		{Date: "2024-05-01", Time: "11:00", Task: "#22222", Project: "web"},
+		{Date: "2024-05-01", Time: "10:20", Task: "#12345", Project: "api"},
+		{Date: "2024-05-01", Time: "09:40", Task: "#12345", Project: "api"},
+	}
+}
+
+func TestBuildWorkBlocks(t *testing.T) {
+	opts := timesheetOptions{Gap: time.Hour, FirstEvent: 30 * time.Minute}
+	blocks := buildWorkBlocks(testTimesheetActivities(), opts)
+
+	want := []struct {
+		task       string
+		start, end string
+		events     int
+	}{
+		{"#12345", "09:10", "10:20", 2}, // session start credited 30m
+		{"#22222", "10:20", "11:00", 1}, // continues the session
+		{"Unassigned", "13:30", "14:00", 1},
+		{"#12345", "08:40", "09:10", 1}, // next day
+	}
+
+	if len(blocks) != len(want) {
+		t.Fatalf("got %d blocks, want %d: %+v", len(blocks), len(want), blocks)
+	}
+	for i, w := range want {
+		b := blocks[i]
+		if b.Task != w.task || b.Start.Format("15:04") != w.start || b.End.Format("15:04") != w.end || b.Events != w.events {
+			t.Errorf("block %d = %s %s-%s (%d events), want %s %s-%s (%d events)",
+				i, b.Task, b.Start.Format("15:04"), b.End.Format("15:04"), b.Events, w.task, w.start, w.end, w.events)
+		}
+	}
+}
+
+func TestBuildWorkBlocksLocalMidnight(t *testing.T) {
+	tests := []struct {
+		zone, time, start string
+	}{
+		{"America/New_York", "00:20", "00:00"}, // UTC midnight is 20:00 the day before
+		{"Europe/Prague", "02:10", "01:40"},    // UTC midnight is 02:00
+	}
+	for _, tt := range tests {
+		loc, err := time.LoadLocation(tt.zone)
+		if err != nil {
+			t.Skipf("zone %s not available: %v", tt.zone, err)
+		}
+		opts := timesheetOptions{Gap: time.Hour, FirstEvent: 30 * time.Minute, Location: loc}
+		blocks := buildWorkBlocks([]gitlab.ActivityEntry{{Date: "2024-05-01", Time: tt.time, Task: "#12345"}}, opts)
+		if len(blocks) != 1 {
+			t.Fatalf("%s: got %d blocks", tt.zone, len(blocks))
+		}
+		if got := blocks[0].Start.In(loc).Format("2006-01-02 15:04"); got != "2024-05-01 "+tt.start {
+			t.Errorf("%s: block starts %s, want 2024-05-01 %s", tt.zone, got, tt.start)
+		}
+	}
+}
+
+func TestBuildTimesheet(t *testing.T) {
+	opts := timesheetOptions{Gap: time.Hour, FirstEvent: 30 * time.Minute, Round: 15 * time.Minute, Rounding: "up"}
+	rows := buildTimesheet(buildWorkBlocks(testTimesheetActivities(), opts), opts)
+
+	want := []string{
+		"2024-05-01 #12345 api 75",
+		"2024-05-01 #22222 web 45",
+		"2024-05-01 Unassigned api 30",
+		"2024-05-02 #12345 api 30",
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(rows), len(want), rows)
+	}
+	for i, r := range rows {
+		got := strings.Join([]string{r.Date, r.Task, r.Project, strconv.Itoa(r.Minutes)}, " ")
+		if got != want[i] {
+			t.Errorf("row %d = %q, want %q", i, got, want[i])
+		}
+	}
+
+	projects := timesheetTotals(rows, func(r timesheetRow) string { return r.Project })
+	if len(projects) != 2 || projects[0].Name != "api" || projects[0].Minutes != 135 {
+		t.Errorf("project totals = %+v", projects)
+	}
+}
+
+func TestRoundDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		mode string
+		want time.Duration
+	}{
+		{70 * time.Minute, "up", 75 * time.Minute},
+		{70 * time.Minute, "nearest", 75 * time.Minute},
+		{67 * time.Minute, "nearest", 60 * time.Minute},
+		{74 * time.Minute, "down", 60 * time.Minute},
+		{60 * time.Minute, "up", 60 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := roundDuration(tt.d, 15*time.Minute, tt.mode); got != tt.want {
+			t.Errorf("roundDuration(%s, %s) = %s, want %s", tt.d, tt.mode, got, tt.want)
+		}
+	}
+
+	if got := roundDuration(7*time.Minute, 0, "up"); got != 7*time.Minute {
+		t.Errorf("unit 0 should not round, got %s", got)
+	}
+}
+
+func TestWriteTimesheetICal(t *testing.T) {
+	start := time.Date(2024, 5, 1, 9, 10, 0, 0, time.UTC)
+	blocks := []workBlock{{Task: "#12345", Project: "api, web", Start: start, End: start.Add(time.Hour), Events: 2}}
+
+	var buf bytes.Buffer
+	if err := writeTimesheetICal(&buf, blocks, start); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"DTSTART:20240501T091000Z\r\n",
+		"DTEND:20240501T101000Z\r\n",
+		`SUMMARY:#12345 (api\, web)` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+var branchCmd = &cobra.Command{
+	Use:   "branch",
+	Short: "Remote branch operations",
+}
+
+var branchListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List project branches",
+	RunE:  runBranchList,
+}
+
+var branchCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a branch from a ref",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBranchCreate,
+}
+
+var branchDeleteCmd = &cobra.Command{
+	Use:   "delete [name...]",
+	Short: "Delete branches, or all branches whose MRs are merged with --merged",
+	RunE:  runBranchDelete,
+}
+
+var branchProtectCmd = &cobra.Command{
+	Use:   "protect <name|pattern>",
+	Short: "Protect a branch or wildcard pattern",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBranchProtect,
+}
+
+var (
+	branchProject string
+
+	// branch list flags
+	branchSearch  string
+	branchMerged  bool
+	branchExclude []string
+	branchJSON    bool
+
+	// branch create flags
+	branchRef string
+
+	// branch delete flags
+	branchYes bool
+
+	// branch protect flags
+	branchPushLevel      string
+	branchMergeLevel     string
+	branchAllowForcePush bool
+	branchUnprotect      bool
+)
+
+func init() {
+	rootCmd.AddCommand(branchCmd)
+	branchCmd.AddCommand(branchListCmd)
+	branchCmd.AddCommand(branchCreateCmd)
+	branchCmd.AddCommand(branchDeleteCmd)
+	branchCmd.AddCommand(branchProtectCmd)
+
+	branchCmd.PersistentFlags().StringVar(&branchProject, "project", "", "project ID or path (required)")
+	branchCmd.MarkPersistentFlagRequired("project")
+
+	branchListCmd.Flags().StringVar(&branchSearch, "search", "", "filter branches by name")
+	branchListCmd.Flags().BoolVar(&branchMerged, "merged", false, "only branches whose MRs are merged")
+	branchListCmd.Flags().StringSliceVar(&branchExclude, "exclude", nil, "exclude branches matching glob (repeatable)")
+	branchListCmd.Flags().BoolVar(&branchJSON, "json", false, "output as JSON")
+
+	branchCreateCmd.Flags().StringVar(&branchRef, "ref", "", "branch, tag or commit to branch from (required)")
+	branchCreateCmd.MarkFlagRequired("ref")
+
+	branchDeleteCmd.Flags().BoolVar(&branchMerged, "merged", false, "delete all branches whose MRs are merged")
+	branchDeleteCmd.Flags().StringSliceVar(&branchExclude, "exclude", nil, "keep branches matching glob (repeatable, with --merged)")
+	branchDeleteCmd.Flags().BoolVarP(&branchYes, "yes", "y", false, "skip confirmation prompt")
+
+	branchProtectCmd.Flags().StringVar(&branchPushLevel, "push", "maintainer", "who can push: no-one, developer, or maintainer")
+	branchProtectCmd.Flags().StringVar(&branchMergeLevel, "merge", "maintainer", "who can merge: no-one, developer, or maintainer")
+	branchProtectCmd.Flags().BoolVar(&branchAllowForcePush, "allow-force-push", false, "allow force push for users who can push")
+	branchProtectCmd.Flags().BoolVar(&branchUnprotect, "unprotect", false, "remove protection instead")
+}
+
+func runBranchList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	if branchMerged {
+		candidates, err := findMergedBranches(client, branchProject, branchExclude)
+		if err != nil {
+			return err
+		}
+		if branchJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(candidates)
+		}
+		if len(candidates) == 0 {
+			fmt.Println("No merged branches found")
+			return nil
+		}
+		printMergedBranches(candidates)
+		return nil
+	}
+
+	branches, err := client.ListBranches(branchProject, branchSearch)
+	if err != nil {
+		return err
+	}
+
+	branches = excludeBranches(branches, branchExclude)
+
+	if len(branches) == 0 {
+		fmt.Println("No branches found")
+		return nil
+	}
+
+	if branchJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(branches)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tFLAGS\tCOMMIT\tLAST COMMIT\tTITLE")
+
+	for _, b := range branches {
+		var flags []string
+		if b.Default {
+			flags = append(flags, "default")
+		}
+		if b.Protected {
+			flags = append(flags, "protected")
+		}
+		if b.Merged {
+			flags = append(flags, "merged")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			b.Name,
+			strings.Join(flags, ","),
+			shortSHA(b.Commit.ID),
+			formatTimestamp(b.Commit.CommittedDate),
+			truncate(b.Commit.Title, 45),
+		)
+	}
+
+	w.Flush()
+	return nil
+}
+
+func runBranchCreate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	branch, err := client.CreateBranch(branchProject, args[0], branchRef)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created branch %s at %s (%s)\n", branch.Name, shortSHA(branch.Commit.ID), branchRef)
+	return nil
+}
+
+func runBranchDelete(cmd *cobra.Command, args []string) error {
+	if branchMerged == (len(args) > 0) {
+		return fmt.Errorf("specify branch names or --merged, but not both")
+	}
+	if len(branchExclude) > 0 && !branchMerged {
+		return fmt.Errorf("--exclude only applies with --merged")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	names := args
+	if branchMerged {
+		candidates, err := findMergedBranches(client, branchProject, branchExclude)
+		if err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			fmt.Println("No merged branches to delete")
+			return nil
+		}
+		printMergedBranches(candidates)
+		fmt.Println()
+
+		names = make([]string, len(candidates))
+		for i, c := range candidates {
+			names[i] = c.Branch
+		}
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: would delete %d branch(es)\n", len(names))
+		for _, name := range names {
+			fmt.Printf("  %s\n", name)
+		}
+		return nil
+	}
+
+	if !branchYes && !confirmPrompt(fmt.Sprintf("Delete %d branch(es) from %s?", len(names), branchProject)) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	failed := 0
+	for _, name := range names {
+		if err := client.DeleteBranch(branchProject, name); err != nil {
+			fmt.Printf("✗ %s: %v\n", name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ deleted %s\n", name)
+	}
+
+	fmt.Printf("\nDeleted %d of %d branch(es)\n", len(names)-failed, len(names))
+	if failed > 0 {
+		return fmt.Errorf("%d branch(es) could not be deleted", failed)
+	}
+	return nil
+}
+
+func runBranchProtect(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	if branchUnprotect {
+		if err := client.UnprotectBranch(branchProject, args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("Removed protection from %s\n", args[0])
+		return nil
+	}
+
+	pushLevel, err := parseAccessLevel(branchPushLevel)
+	if err != nil {
+		return fmt.Errorf("invalid --push value: %w", err)
+	}
+	mergeLevel, err := parseAccessLevel(branchMergeLevel)
+	if err != nil {
+		return fmt.Errorf("invalid --merge value: %w", err)
+	}
+
+	protected, err := client.ProtectBranch(branchProject, gitlab.ProtectBranchOptions{
+		Name:             args[0],
+		PushAccessLevel:  pushLevel,
+		MergeAccessLevel: mergeLevel,
+		AllowForcePush:   branchAllowForcePush,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Protected %s (push: %s, merge: %s", protected.Name, branchPushLevel, branchMergeLevel)
+	if protected.AllowForcePush {
+		fmt.Print(", force push allowed")
+	}
+	fmt.Println(")")
+	return nil
+}
+
+// mergedBranch is a remote branch whose MR has been merged and which has
+// no commits beyond what was merged.
+type mergedBranch struct {
+	Branch     string `json:"branch"`
+	MRIID      int    `json:"mr_iid"`
+	MRTitle    string `json:"mr_title"`
+	MergedAt   string `json:"merged_at"`
+	LastCommit string `json:"last_commit"`
+}
+
+func findMergedBranches(client *gitlab.Client, projectID string, excludes []string) ([]mergedBranch, error) {
+	branches, err := client.ListBranches(projectID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := client.ListProjectMRs(projectID, "merged")
+	if err != nil {
+		return nil, err
+	}
+
+	opened, err := client.ListProjectMRs(projectID, "opened")
+	if err != nil {
+		return nil, err
+	}
+
+	return selectMergedBranches(excludeBranches(branches, excludes), merged, opened), nil
+}
+
+// selectMergedBranches picks branches that were the source of a merged MR in
+// the same project. Default and protected branches, branches that still have
+// an open MR, and branches with commits pushed after the merge are kept.
+func selectMergedBranches(branches []gitlab.Branch, merged, opened []gitlab.MergeRequest) []mergedBranch {
+	hasOpenMR := make(map[string]bool)
+	for _, mr := range opened {
+		hasOpenMR[mr.SourceBranch] = true
+	}
+
+	mergedBySource := make(map[string][]gitlab.MergeRequest)
+	for _, mr := range merged {
+		// Fork MRs name branches in another repository
+		if mr.SourceProjectID != 0 && mr.SourceProjectID != mr.ProjectID {
+			continue
+		}
+		mergedBySource[mr.SourceBranch] = append(mergedBySource[mr.SourceBranch], mr)
+	}
+
+	var result []mergedBranch
+	for _, b := range branches {
+		if b.Default || b.Protected || hasOpenMR[b.Name] {
+			continue
+		}
+		for _, mr := range mergedBySource[b.Name] {
+			if mr.SHA != "" && mr.SHA != b.Commit.ID {
+				continue
+			}
+			result = append(result, mergedBranch{
+				Branch:     b.Name,
+				MRIID:      mr.IID,
+				MRTitle:    mr.Title,
+				MergedAt:   mr.MergedAt,
+				LastCommit: b.Commit.CommittedDate,
+			})
+			break
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Branch < result[j].Branch
+	})
+
+	return result
+}
+
+// excludeBranches drops branches whose full name matches any glob pattern.
+func excludeBranches(branches []gitlab.Branch, patterns []string) []gitlab.Branch {
+	if len(patterns) == 0 {
+		return branches
+	}
+
+	var kept []gitlab.Branch
+	for _, b := range branches {
+		if !matchesAnyPattern(b.Name, patterns) {
+			kept = append(kept, b)
+		}
+	}
+	return kept
+}
+
+func matchesAnyPattern(name string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func printMergedBranches(candidates []mergedBranch) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BRANCH\tMR\tMERGED\tTITLE")
+
+	for _, c := range candidates {
+		fmt.Fprintf(w, "%s\t!%d\t%s\t%s\n",
+			c.Branch, c.MRIID, formatTimestamp(c.MergedAt), truncate(c.MRTitle, 45))
+	}
+
+	w.Flush()
+}
+
+// parseAccessLevel maps a role name to GitLab's numeric access level.
+func parseAccessLevel(name string) (int, error) {
+	switch strings.ToLower(name) {
+	case "no-one", "none", "0":
+		return 0, nil
+	case "developer", "developers", "30":
+		return 30, nil
+	case "maintainer", "maintainers", "40":
+		return 40, nil
+	case "admin", "60":
+		return 60, nil
+	}
+	return 0, fmt.Errorf("%q: must be no-one, developer, maintainer, or admin", name)
+}
+
+// confirmPrompt asks a yes/no question on stdin. Anything but y/yes is no.
+func confirmPrompt(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, _ := reader.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestSelectMergedBranches(t *testing.T) {
+	branches := []gitlab.Branch{
+		{Name: "main", Default: true, Commit: gitlab.Commit{ID: "m1"}},
+		{Name: "release/1.0", Protected: true, Commit: gitlab.Commit{ID: "r1"}},
+		{Name: "feature/done", Commit: gitlab.Commit{ID: "d1"}},
+		{Name: "feature/reopened", Commit: gitlab.Commit{ID: "o1"}},
+		{Name: "feature/pushed-after", Commit: gitlab.Commit{ID: "new"}},
+		{Name: "feature/no-mr", Commit: gitlab.Commit{ID: "n1"}},
+		{Name: "fork-name", Commit: gitlab.Commit{ID: "f1"}},
+		{Name: "a-first", Commit: gitlab.Commit{ID: "a1"}},
+	}
+	merged := []gitlab.MergeRequest{
+		{IID: 1, ProjectID: 5, SourceProjectID: 5, SourceBranch: "main", SHA: "m1"},
+		{IID: 2, ProjectID: 5, SourceProjectID: 5, SourceBranch: "release/1.0", SHA: "r1"},
+		{IID: 3, ProjectID: 5, SourceProjectID: 5, SourceBranch: "feature/done", SHA: "d1"},
+		{IID: 4, ProjectID: 5, SourceProjectID: 5, SourceBranch: "feature/reopened", SHA: "o1"},
+		{IID: 5, ProjectID: 5, SourceProjectID: 5, SourceBranch: "feature/pushed-after", SHA: "old"},
+		{IID: 6, ProjectID: 5, SourceProjectID: 9, SourceBranch: "fork-name", SHA: "f1"},
+		{IID: 7, ProjectID: 5, SourceBranch: "a-first", SHA: "a1"},
+	}
+	opened := []gitlab.MergeRequest{
+		{IID: 8, ProjectID: 5, SourceBranch: "feature/reopened"},
+	}
+
+	got := selectMergedBranches(branches, merged, opened)
+
+	want := []string{"a-first", "feature/done"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d branches %+v, want %v", len(got), got, want)
+	}
+	for i, name := range want {
+		if got[i].Branch != name {
+			t.Errorf("got[%d] = %s, want %s", i, got[i].Branch, name)
+		}
+	}
+	if got[1].MRIID != 3 {
+		t.Errorf("feature/done MR = !%d, want !3", got[1].MRIID)
+	}
+}
+
+func TestExcludeBranches(t *testing.T) {
+	branches := []gitlab.Branch{
+		{Name: "main"},
+		{Name: "release/1.0"},
+		{Name: "release/2.0"},
+		{Name: "feature/release-notes"},
+		{Name: "hotfix-1"},
+	}
+
+	got := excludeBranches(branches, []string{"release/*", "hotfix-*"})
+
+	var names []string
+	for _, b := range got {
+		names = append(names, b.Name)
+	}
+	want := []string{"main", "feature/release-notes"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Errorf("excludeBranches = %v, want %v", names, want)
+	}
+
+	if len(excludeBranches(branches, nil)) != len(branches) {
+		t.Error("no patterns should keep all branches")
+	}
+}
+
+func TestParseAccessLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"no-one", 0, false},
+		{"developer", 30, false},
+		{"Maintainer", 40, false},
+		{"40", 40, false},
+		{"owner", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseAccessLevel(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseAccessLevel(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseAccessLevel(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// gitOutput runs the local git binary and returns its trimmed stdout.
+// Stderr is included in the error so git's own message reaches the user.
+func gitOutput(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), msg)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func gitRun(args ...string) error {
+	_, err := gitOutput(args...)
+	return err
+}
+
+// gitEnsureCleanTree fails when the current directory is not a git work tree
+// or has uncommitted changes to tracked files.
+func gitEnsureCleanTree() error {
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("git executable not found in PATH")
+	}
+
+	if _, err := gitOutput("rev-parse", "--is-inside-work-tree"); err != nil {
+		return fmt.Errorf("not inside a git repository")
+	}
+
+	status, err := gitOutput("status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return err
+	}
+	if status != "" {
+		return fmt.Errorf("working tree has uncommitted changes; commit or stash them first")
+	}
+
+	return nil
+}
+
+func gitBranchExists(name string) bool {
+	_, err := gitOutput("rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+func gitCurrentBranch() (string, error) {
+	return gitOutput("rev-parse", "--abbrev-ref", "HEAD")
+}
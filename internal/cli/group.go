@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+var groupCmd = &cobra.Command{
+	Use:   "group",
+	Short: "Group operations",
+}
+
+var groupListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List accessible groups or the subgroups of a group",
+	RunE:  runGroupList,
+}
+
+var groupShowCmd = &cobra.Command{
+	Use:   "show <group>",
+	Short: "Show a group with its projects and open MR counts",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGroupShow,
+}
+
+var (
+	groupSearch    string
+	groupOwned     bool
+	groupParent    string
+	groupSubgroups bool
+	groupLimit     int
+	groupJSON      bool
+
+	// group show flags
+	groupShowSubgroups bool
+)
+
+func init() {
+	rootCmd.AddCommand(groupCmd)
+	groupCmd.AddCommand(groupListCmd)
+	groupCmd.AddCommand(groupShowCmd)
+
+	groupListCmd.Flags().StringVar(&groupSearch, "search", "", "filter by group name or path")
+	groupListCmd.Flags().BoolVar(&groupOwned, "owned", false, "only groups owned by me")
+	groupListCmd.Flags().StringVar(&groupParent, "parent", "", "list subgroups of this group (ID or path)")
+	groupListCmd.Flags().BoolVar(&groupSubgroups, "include-subgroups", false, "with --parent, list all descendant groups")
+	groupListCmd.Flags().IntVar(&groupLimit, "limit", 20, "number of results")
+	groupListCmd.Flags().BoolVar(&groupJSON, "json", false, "output as JSON")
+
+	groupShowCmd.Flags().BoolVar(&groupShowSubgroups, "include-subgroups", true, "include projects of subgroups")
+	groupShowCmd.Flags().BoolVar(&groupJSON, "json", false, "output as JSON")
+}
+
+func runGroupList(cmd *cobra.Command, args []string) error {
+	if groupSubgroups && groupParent == "" {
+		return fmt.Errorf("--include-subgroups requires --parent")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	groups, err := client.ListGroups(gitlab.ListGroupsOptions{
+		Search:    groupSearch,
+		Owned:     groupOwned,
+		ParentID:  groupParent,
+		Recursive: groupSubgroups,
+		PerPage:   groupLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		fmt.Println("No groups found")
+		return nil
+	}
+
+	if groupJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(groups)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPATH\tNAME\tVISIBILITY")
+
+	for _, g := range groups {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", g.ID, g.FullPath, g.Name, g.Visibility)
+	}
+
+	w.Flush()
+	return nil
+}
+
+// groupProject is a project row of group show.
+type groupProject struct {
+	gitlab.Project
+	OpenMRs int `json:"open_mrs"`
+}
+
+func runGroupShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	group, err := client.GetGroup(args[0])
+	if err != nil {
+		return err
+	}
+
+	groupRef := strconv.Itoa(group.ID)
+
+	subgroups, err := client.ListDescendantGroups(groupRef)
+	if err != nil {
+		return err
+	}
+
+	projects, err := client.ListGroupProjects(groupRef, groupShowSubgroups)
+	if err != nil {
+		return err
+	}
+
+	mrs, err := client.ListGroupMRs(groupRef, "opened")
+	if err != nil {
+		return err
+	}
+
+	rows := groupProjectRows(projects, mrs)
+
+	if groupJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			*gitlab.Group
+			Subgroups int            `json:"subgroups"`
+			OpenMRs   int            `json:"open_mrs"`
+			Projects  []groupProject `json:"projects"`
+		}{group, len(subgroups), len(mrs), rows})
+	}
+
+	archived := 0
+	for _, p := range projects {
+		if p.Archived {
+			archived++
+		}
+	}
+
+	fmt.Printf("Group: %s (%s)\n", group.FullName, group.FullPath)
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("ID:           %d\n", group.ID)
+	fmt.Printf("Visibility:   %s\n", group.Visibility)
+	fmt.Printf("URL:          %s\n", group.WebURL)
+	if group.Description != "" {
+		fmt.Printf("Description:  %s\n", truncate(group.Description, 70))
+	}
+	fmt.Printf("Subgroups:    %d\n", len(subgroups))
+	fmt.Printf("Projects:     %d (%d archived)\n", len(projects), archived)
+	fmt.Printf("Open MRs:     %d\n", len(mrs))
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPATH\tOPEN MRS\tDEFAULT BRANCH")
+	for _, p := range rows {
+		path := p.PathWithNamespace
+		if p.Archived {
+			path += " (archived)"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", p.ID, path, p.OpenMRs, dashIfEmpty(p.DefaultBranch))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// groupProjectRows pairs each project with its number of open MRs, sorted by
+// open MRs (descending) and then by path.
+func groupProjectRows(projects []gitlab.Project, openMRs []gitlab.MergeRequest) []groupProject {
+	counts := make(map[int]int)
+	for _, mr := range openMRs {
+		counts[mr.ProjectID]++
+	}
+
+	rows := make([]groupProject, len(projects))
+	for i, p := range projects {
+		rows[i] = groupProject{Project: p, OpenMRs: counts[p.ID]}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].OpenMRs != rows[j].OpenMRs {
+			return rows[i].OpenMRs > rows[j].OpenMRs
+		}
+		return rows[i].PathWithNamespace < rows[j].PathWithNamespace
+	})
+	return rows
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestGroupProjectRows(t *testing.T) {
+	projects := []gitlab.Project{
+		{ID: 1, PathWithNamespace: "team/zeta"},
+		{ID: 2, PathWithNamespace: "team/alpha"},
+		{ID: 3, PathWithNamespace: "team/sub/beta"},
+		{ID: 4, PathWithNamespace: "team/gamma"},
+	}
+	mrs := []gitlab.MergeRequest{
+		{ProjectID: 3}, {ProjectID: 3}, {ProjectID: 1}, {ProjectID: 99},
+	}
+
+	rows := groupProjectRows(projects, mrs)
+
+	want := []struct {
+		path string
+		open int
+	}{
+		{"team/sub/beta", 2},
+		{"team/zeta", 1},
+		{"team/alpha", 0},
+		{"team/gamma", 0},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		if rows[i].PathWithNamespace != w.path || rows[i].OpenMRs != w.open {
+			t.Errorf("row %d = %s (%d), want %s (%d)", i, rows[i].PathWithNamespace, rows[i].OpenMRs, w.path, w.open)
+		}
+	}
+}
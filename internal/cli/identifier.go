@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 var (
@@ -17,12 +19,14 @@ type IdentifierType int
 const (
 	IdentifierTypeInvalid IdentifierType = iota
 	IdentifierTypeIID                    // NNNNN - resolved via unified IID-first, task# fallback
+	IdentifierTypeTask                   // ABC-123 - a task reference of a non-numeric task pattern
 )
 
 // ParsedIdentifier holds the result of parsing a user-provided MR identifier
 type ParsedIdentifier struct {
 	Type     IdentifierType
 	Value    int    // The numeric value extracted
+	Task     string // The task reference, for IdentifierTypeTask
 	RawInput string // Original input for error messages
 }
 
@@ -54,3 +58,18 @@ func ParseIdentifier(input string) (*ParsedIdentifier, error) {
 
 	return nil, fmt.Errorf("invalid identifier format: %s", input)
 }
+
+// parseMRIdentifier parses input like ParseIdentifier and, failing that, as
+// a task reference such as ABC-123 that the task patterns yield as a whole.
+// Hash-prefixed input stays invalid.
+func parseMRIdentifier(tasks *task.Matcher, input string) (*ParsedIdentifier, error) {
+	parsed, err := ParseIdentifier(input)
+	if err == nil || strings.HasPrefix(input, "#") || !tasks.IsReference(input) {
+		return parsed, err
+	}
+	return &ParsedIdentifier{
+		Type:     IdentifierTypeTask,
+		Task:     input,
+		RawInput: input,
+	}, nil
+}
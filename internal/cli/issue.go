@@ -0,0 +1,534 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+)
+
+var issueCmd = &cobra.Command{
+	Use:   "issue",
+	Short: "Issue operations",
+}
+
+var issueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List issues",
+	RunE:  runIssueList,
+}
+
+var issueShowCmd = &cobra.Command{
+	Use:   "show <issue>",
+	Short: "Show issue details and related merge requests",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runIssueShow,
+}
+
+var issueCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an issue",
+	RunE:  runIssueCreate,
+}
+
+var issueUpdateCmd = &cobra.Command{
+	Use:   "update <issue>",
+	Short: "Update issue properties",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runIssueUpdate,
+}
+
+var issueCloseCmd = &cobra.Command{
+	Use:   "close <issue>",
+	Short: "Close an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runIssueClose,
+}
+
+var issueCommentCmd = &cobra.Command{
+	Use:   "comment <issue>",
+	Short: "Add a comment to an issue",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runIssueComment,
+}
+
+var (
+	issueProject string
+	issueJSON    bool
+
+	// issue list flags
+	issueListMine   bool
+	issueListState  string
+	issueListLabels string
+	issueListSearch string
+	issueListLimit  int
+
+	// issue create/update flags
+	issueTitle        string
+	issueDescription  string
+	issueLabels       []string
+	issueRemoveLabels []string
+	issueAssign       []string
+	issueDue          string
+	issueState        string
+
+	// issue comment flags
+	issueCommentBody string
+)
+
+func init() {
+	rootCmd.AddCommand(issueCmd)
+	issueCmd.AddCommand(issueListCmd)
+	issueCmd.AddCommand(issueShowCmd)
+	issueCmd.AddCommand(issueCreateCmd)
+	issueCmd.AddCommand(issueUpdateCmd)
+	issueCmd.AddCommand(issueCloseCmd)
+	issueCmd.AddCommand(issueCommentCmd)
+
+	issueCmd.PersistentFlags().StringVar(&issueProject, "project", "", "project ID or path")
+
+	issueListCmd.Flags().BoolVar(&issueListMine, "mine", false, "only issues assigned to me")
+	issueListCmd.Flags().StringVar(&issueListState, "state", "opened", "issue state: opened, closed, or all")
+	issueListCmd.Flags().StringVar(&issueListLabels, "labels", "", "filter by labels (comma-separated)")
+	issueListCmd.Flags().StringVar(&issueListSearch, "search", "", "search in title and description")
+	issueListCmd.Flags().IntVar(&issueListLimit, "limit", 20, "number of results")
+	issueListCmd.Flags().BoolVar(&issueJSON, "json", false, "output as JSON")
+
+	issueShowCmd.Flags().BoolVar(&issueJSON, "json", false, "output as JSON")
+
+	issueCreateCmd.Flags().StringVar(&issueTitle, "title", "", "issue title (required)")
+	issueCreateCmd.Flags().StringVar(&issueDescription, "description", "", "issue description")
+	issueCreateCmd.Flags().StringSliceVar(&issueLabels, "label", nil, "label to apply (repeatable)")
+	issueCreateCmd.Flags().StringSliceVar(&issueAssign, "assign", nil, "assign user by username or ID (repeatable)")
+	issueCreateCmd.Flags().StringVar(&issueDue, "due", "", "due date (YYYY-MM-DD)")
+	issueCreateCmd.Flags().BoolVar(&issueJSON, "json", false, "output as JSON")
+	issueCreateCmd.MarkFlagRequired("title")
+
+	issueUpdateCmd.Flags().StringVar(&issueTitle, "title", "", "new issue title")
+	issueUpdateCmd.Flags().StringVar(&issueDescription, "description", "", "new issue description")
+	issueUpdateCmd.Flags().StringSliceVar(&issueLabels, "add-label", nil, "add label (repeatable)")
+	issueUpdateCmd.Flags().StringSliceVar(&issueRemoveLabels, "remove-label", nil, "remove label (repeatable)")
+	issueUpdateCmd.Flags().StringSliceVar(&issueAssign, "assign", nil, "replace assignees by username or ID (repeatable)")
+	issueUpdateCmd.Flags().StringVar(&issueDue, "due", "", "due date (YYYY-MM-DD, empty string to clear)")
+	issueUpdateCmd.Flags().StringVar(&issueState, "state", "", "state event: close or reopen")
+	issueUpdateCmd.Flags().BoolVar(&issueJSON, "json", false, "output as JSON")
+
+	issueCommentCmd.Flags().StringVarP(&issueCommentBody, "body", "m", "", "comment text (required)")
+	issueCommentCmd.MarkFlagRequired("body")
+}
+
+func runIssueList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	opts := gitlab.ListIssuesOptions{
+		ProjectID: issueProject,
+		State:     issueListState,
+		Labels:    issueListLabels,
+		Search:    issueListSearch,
+		PerPage:   issueListLimit,
+	}
+
+	if issueListMine {
+		opts.Scope = "assigned_to_me"
+	}
+
+	issues, err := client.ListIssues(opts)
+	if err != nil {
+		return err
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No issues found")
+		return nil
+	}
+
+	if issueJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issues)
+	}
+
+	printIssueTable(issues)
+	return nil
+}
+
+func runIssueShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	issue, err := resolveIssue(client, taskMatcher(cfg), issueProject, args[0])
+	if err != nil {
+		return err
+	}
+
+	projectID := strconv.Itoa(issue.ProjectID)
+
+	related, err := client.GetIssueRelatedMRs(projectID, issue.IID)
+	if err != nil {
+		return err
+	}
+
+	closedBy, err := client.GetIssueClosedBy(projectID, issue.IID)
+	if err != nil {
+		return err
+	}
+
+	if issueJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			*gitlab.Issue
+			RelatedMergeRequests []gitlab.MergeRequest `json:"related_merge_requests"`
+			ClosedBy             []gitlab.MergeRequest `json:"closed_by"`
+		}{issue, related, closedBy})
+	}
+
+	fmt.Printf("Issue #%d: %s\n", issue.IID, issue.Title)
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("Project:      %d\n", issue.ProjectID)
+	fmt.Printf("State:        %s\n", issue.State)
+	fmt.Printf("Author:       %s\n", issue.Author.Name)
+	fmt.Printf("Assignees:    %s\n", joinUsernames(issue.Assignees, "(none)"))
+	if len(issue.Labels) > 0 {
+		fmt.Printf("Labels:       %s\n", strings.Join(issue.Labels, ", "))
+	}
+	if issue.DueDate != "" {
+		fmt.Printf("Due:          %s\n", issue.DueDate)
+	}
+	fmt.Printf("Created:      %s\n", formatTimestamp(issue.CreatedAt))
+	fmt.Printf("URL:          %s\n", issue.WebURL)
+
+	if issue.Description != "" {
+		fmt.Println()
+		fmt.Println(wrapText(issue.Description, 80))
+	}
+
+	if len(related) > 0 {
+		closing := make(map[int]bool, len(closedBy))
+		for _, mr := range closedBy {
+			closing[mr.ID] = true
+		}
+
+		fmt.Println()
+		fmt.Println("── Related merge requests ──")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tIID\tSTATE\tCLOSES\tTITLE")
+		for _, mr := range related {
+			closes := ""
+			if closing[mr.ID] {
+				closes = "yes"
+			}
+			fmt.Fprintf(w, "%d\t!%d\t%s\t%s\t%s\n", mr.ID, mr.IID, mr.State, closes, truncate(mr.Title, 50))
+		}
+		w.Flush()
+	}
+
+	return nil
+}
+
+func runIssueCreate(cmd *cobra.Command, args []string) error {
+	// --project is a persistent flag of issue, optional for the other
+	// subcommands, so it cannot be marked required here
+	if issueProject == "" {
+		return fmt.Errorf("required flag \"project\" not set")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	assigneeIDs, err := resolveUserIDs(client, issueAssign)
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.CreateIssue(issueProject, gitlab.CreateIssueOptions{
+		Title:       issueTitle,
+		Description: issueDescription,
+		Labels:      issueLabels,
+		AssigneeIDs: assigneeIDs,
+		DueDate:     issueDue,
+	})
+	if err != nil {
+		return err
+	}
+
+	if issueJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issue)
+	}
+
+	fmt.Printf("Created issue #%d: %s\n", issue.IID, issue.Title)
+	fmt.Printf("URL: %s\n", issue.WebURL)
+	return nil
+}
+
+func runIssueUpdate(cmd *cobra.Command, args []string) error {
+	opts := gitlab.UpdateIssueOptions{
+		AddLabels:    issueLabels,
+		RemoveLabels: issueRemoveLabels,
+	}
+
+	if cmd.Flags().Changed("title") {
+		opts.Title = &issueTitle
+	}
+	if cmd.Flags().Changed("description") {
+		opts.Description = &issueDescription
+	}
+	if cmd.Flags().Changed("due") {
+		opts.DueDate = &issueDue
+	}
+	if cmd.Flags().Changed("state") {
+		if issueState != "close" && issueState != "reopen" {
+			return fmt.Errorf("invalid --state value %q: must be \"close\" or \"reopen\"", issueState)
+		}
+		opts.StateEvent = &issueState
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	if cmd.Flags().Changed("assign") {
+		opts.AssigneeIDs, err = resolveUserIDs(client, issueAssign)
+		if err != nil {
+			return err
+		}
+		if opts.AssigneeIDs == nil {
+			opts.AssigneeIDs = []int{}
+		}
+	}
+
+	issue, err := resolveIssue(client, taskMatcher(cfg), issueProject, args[0])
+	if err != nil {
+		return err
+	}
+
+	updated, err := client.UpdateIssue(strconv.Itoa(issue.ProjectID), issue.IID, opts)
+	if err != nil {
+		return err
+	}
+
+	if issueJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(updated)
+	}
+
+	fmt.Printf("Updated issue #%d: %s\n", updated.IID, updated.Title)
+	fmt.Printf("URL: %s\n", updated.WebURL)
+	return nil
+}
+
+func runIssueClose(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	issue, err := resolveIssue(client, taskMatcher(cfg), issueProject, args[0])
+	if err != nil {
+		return err
+	}
+
+	stateEvent := "close"
+	updated, err := client.UpdateIssue(strconv.Itoa(issue.ProjectID), issue.IID, gitlab.UpdateIssueOptions{
+		StateEvent: &stateEvent,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Closed issue #%d: %s\n", updated.IID, updated.Title)
+	return nil
+}
+
+func runIssueComment(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	issue, err := resolveIssue(client, taskMatcher(cfg), issueProject, args[0])
+	if err != nil {
+		return err
+	}
+
+	note, err := client.CreateIssueNote(strconv.Itoa(issue.ProjectID), issue.IID, issueCommentBody)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Commented on issue #%d (note %d)\n", issue.IID, note.ID)
+	return nil
+}
+
+func printIssueTable(issues []gitlab.Issue) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IID\tPROJECT\tSTATE\tTITLE\tASSIGNEES\tLABELS")
+
+	for _, issue := range issues {
+		fmt.Fprintf(w, "#%d\t%d\t%s\t%s\t%s\t%s\n",
+			issue.IID,
+			issue.ProjectID,
+			issue.State,
+			truncate(issue.Title, 45),
+			joinUsernames(issue.Assignees, "-"),
+			truncate(strings.Join(issue.Labels, ","), 30),
+		)
+	}
+
+	w.Flush()
+}
+
+// issueRef is a parsed issue identifier. Exactly one of IID and TaskNum is set.
+type issueRef struct {
+	Project string
+	IID     int
+	TaskNum int
+}
+
+// parseIssueRef parses an issue identifier:
+//   - "123"           issue IID in the --project project
+//   - "group/repo#123" issue IID in an explicit project
+//   - "#51706"        task number searched in issue titles
+func parseIssueRef(input string) (issueRef, error) {
+	input = strings.TrimSpace(input)
+
+	if strings.HasPrefix(input, "#") {
+		n, err := strconv.Atoi(input[1:])
+		if err != nil || n <= 0 {
+			return issueRef{}, fmt.Errorf("invalid issue identifier %q", input)
+		}
+		return issueRef{TaskNum: n}, nil
+	}
+
+	if i := strings.LastIndex(input, "#"); i > 0 {
+		n, err := strconv.Atoi(input[i+1:])
+		if err != nil || n <= 0 {
+			return issueRef{}, fmt.Errorf("invalid issue identifier %q", input)
+		}
+		return issueRef{Project: input[:i], IID: n}, nil
+	}
+
+	n, err := strconv.Atoi(input)
+	if err != nil || n <= 0 {
+		return issueRef{}, fmt.Errorf("invalid issue identifier %q: use IID, project#IID, or #TASK", input)
+	}
+	return issueRef{IID: n}, nil
+}
+
+// resolveIssue loads the issue named by input. Task numbers are matched
+// against issue titles, scoped to project when one is given.
+func resolveIssue(client *gitlab.Client, tasks *task.Matcher, project, input string) (*gitlab.Issue, error) {
+	ref, err := parseIssueRef(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref.TaskNum == 0 {
+		if ref.Project == "" {
+			ref.Project = project
+		}
+		if ref.Project == "" {
+			return nil, fmt.Errorf("--project is required to look up issue %s", input)
+		}
+		return client.GetIssue(ref.Project, ref.IID)
+	}
+
+	candidates, err := client.ListIssues(gitlab.ListIssuesOptions{
+		ProjectID: project,
+		State:     "all",
+		Search:    strconv.Itoa(ref.TaskNum),
+		PerPage:   100,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []gitlab.Issue
+	for _, issue := range candidates {
+		if containsTaskNumber(tasks, task.ProjectFromReference(issue.References.Full), issue.Title, ref.TaskNum) {
+			matches = append(matches, issue)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("No issue found matching %s", input)
+	case 1:
+		fmt.Printf("Resolved: %s (task#) → issue #%d (project-%d)\n", input, matches[0].IID, matches[0].ProjectID)
+		return &matches[0], nil
+	default:
+		var sb strings.Builder
+		sb.WriteString(fmt.Sprintf("ERROR: Multiple issues match %s:\n", input))
+		for _, issue := range matches {
+			sb.WriteString(fmt.Sprintf("  #%d (project-%d) - %s\n", issue.IID, issue.ProjectID, issue.Title))
+		}
+		sb.WriteString("Use project#IID to specify")
+		return nil, fmt.Errorf("%s", sb.String())
+	}
+}
+
+func resolveUserIDs(client *gitlab.Client, refs []string) ([]int, error) {
+	var ids []int
+	for _, ref := range refs {
+		id, err := client.ResolveUserID(ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolving user '%s': %w", ref, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    issueRef
+		wantErr bool
+	}{
+		{"42", issueRef{IID: 42}, false},
+		{"group/repo#42", issueRef{Project: "group/repo", IID: 42}, false},
+		{"253#7", issueRef{Project: "253", IID: 7}, false},
+		{"#51706", issueRef{TaskNum: 51706}, false},
+		{" 12 ", issueRef{IID: 12}, false},
+		{"abc", issueRef{}, true},
+		{"#", issueRef{}, true},
+		{"group/repo#x", issueRef{}, true},
+		{"0", issueRef{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseIssueRef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseIssueRef(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseIssueRef(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendClosingReferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		refs        []string
+		want        string
+		wantErr     bool
+	}{
+		{"no refs keeps description", "Body", nil, "Body", false},
+		{"empty description", "", []string{"12"}, "Closes #12", false},
+		{"hash prefix accepted", "Body\n", []string{"#12"}, "Body\n\nCloses #12", false},
+		{"cross-project and multiple", "Body", []string{"3", "group/repo#4"}, "Body\n\nCloses #3\nCloses group/repo#4", false},
+		{"invalid ref", "Body", []string{"abc"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendClosingReferences(tt.description, tt.refs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunIssueCreateRequiresProject(t *testing.T) {
+	issueProject, issueTitle = "", "x"
+	defer func() { issueTitle = "" }()
+
+	if err := runIssueCreate(issueCreateCmd, nil); err == nil || !strings.Contains(err.Error(), "project") {
+		t.Errorf("expected missing --project error, got %v", err)
+	}
+}
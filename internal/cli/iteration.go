@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+var iterationCmd = &cobra.Command{
+	Use:   "iteration",
+	Short: "Iteration operations for projects and groups",
+}
+
+var iterationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List iterations",
+	RunE:  runIterationList,
+}
+
+var iterationShowCmd = &cobra.Command{
+	Use:   "show <id|title>",
+	Short: "Show an iteration with its issues rolled up by state",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runIterationShow,
+}
+
+var (
+	iterationProject string
+	iterationGroup   string
+	iterationJSON    bool
+
+	// iteration list flags
+	iterationState  string
+	iterationSearch string
+)
+
+func init() {
+	rootCmd.AddCommand(iterationCmd)
+	iterationCmd.AddCommand(iterationListCmd)
+	iterationCmd.AddCommand(iterationShowCmd)
+
+	iterationCmd.PersistentFlags().StringVar(&iterationProject, "project", "", "project ID or path")
+	iterationCmd.PersistentFlags().StringVar(&iterationGroup, "group", "", "group ID or path")
+	iterationCmd.MarkFlagsOneRequired("project", "group")
+	iterationCmd.MarkFlagsMutuallyExclusive("project", "group")
+
+	iterationListCmd.Flags().StringVar(&iterationState, "state", "opened", "iteration state: opened, upcoming, current, closed, or all")
+	iterationListCmd.Flags().StringVar(&iterationSearch, "search", "", "filter by title")
+	iterationListCmd.Flags().BoolVar(&iterationJSON, "json", false, "output as JSON")
+
+	iterationShowCmd.Flags().BoolVar(&iterationJSON, "json", false, "output as JSON")
+}
+
+func runIterationList(cmd *cobra.Command, args []string) error {
+	switch iterationState {
+	case "opened", "upcoming", "current", "closed", "all":
+	default:
+		return fmt.Errorf("invalid --state '%s' (use opened, upcoming, current, closed or all)", iterationState)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	iterations, err := client.ListIterations(gitlab.ListIterationsOptions{
+		ProjectID: iterationProject,
+		GroupID:   iterationGroup,
+		State:     iterationState,
+		Search:    iterationSearch,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(iterations) == 0 {
+		fmt.Println("No iterations found")
+		return nil
+	}
+
+	if iterationJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(iterations)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSTATE\tSTART\tDUE\tGROUP")
+
+	for _, it := range iterations {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\n",
+			it.ID, truncate(iterationTitle(it), 40), it.StateName(), dashIfEmpty(it.StartDate), dashIfEmpty(it.DueDate), it.GroupID)
+	}
+
+	w.Flush()
+	return nil
+}
+
+func runIterationShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	iteration, err := resolveIteration(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.GetIterationIssues(iterationProject, iterationGroup, iteration.ID)
+	if err != nil {
+		return err
+	}
+
+	issueStates := make([]string, len(issues))
+	for i, issue := range issues {
+		issueStates[i] = issue.State
+	}
+	issueRollup := rollupByState(issueStates)
+
+	if iterationJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			*gitlab.Iteration
+			StateName string         `json:"state_name"`
+			Issues    map[string]int `json:"issues"`
+		}{iteration, iteration.StateName(), issueRollup})
+	}
+
+	fmt.Printf("Iteration: %s\n", iterationTitle(*iteration))
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("State:        %s\n", iteration.StateName())
+	fmt.Printf("Dates:        %s → %s\n", dashIfEmpty(iteration.StartDate), dashIfEmpty(iteration.DueDate))
+	fmt.Printf("URL:          %s\n", iteration.WebURL)
+	fmt.Printf("Issues:       %s\n", formatRollup(issueRollup, []string{"opened", "closed"}))
+
+	if len(issues) > 0 {
+		fmt.Printf("Progress:     %d%% (%d of %d done)\n", issueRollup["closed"]*100/len(issues), issueRollup["closed"], len(issues))
+	}
+
+	var openIssues []gitlab.Issue
+	for _, issue := range issues {
+		if issue.State == "opened" {
+			openIssues = append(openIssues, issue)
+		}
+	}
+	if len(openIssues) > 0 {
+		fmt.Println()
+		fmt.Println("── Open issues ──")
+		printIssueTable(openIssues)
+	}
+
+	return nil
+}
+
+// resolveIteration finds an iteration of the selected project or group by
+// numeric ID or exact title. GitLab has no endpoint for a single iteration,
+// so both go through the list.
+func resolveIteration(client *gitlab.Client, ref string) (*gitlab.Iteration, error) {
+	opts := gitlab.ListIterationsOptions{
+		ProjectID: iterationProject,
+		GroupID:   iterationGroup,
+		State:     "all",
+	}
+	id, err := strconv.Atoi(ref)
+	if err != nil {
+		opts.Search = ref
+	}
+
+	iterations, err := client.ListIterations(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range iterations {
+		if (id != 0 && iterations[i].ID == id) || (id == 0 && iterations[i].Title == ref) {
+			return &iterations[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("iteration '%s' not found", ref)
+}
+
+// iterationTitle names an iteration. Iterations of an automatic cadence
+// have no title, so those are named by their dates.
+func iterationTitle(it gitlab.Iteration) string {
+	if it.Title != "" {
+		return it.Title
+	}
+	return fmt.Sprintf("%s – %s", dashIfEmpty(it.StartDate), dashIfEmpty(it.DueDate))
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestResolveIteration(t *testing.T) {
+	defer func() { iterationProject = "" }()
+
+	iterations := []gitlab.Iteration{
+		{ID: 5, GroupID: 3, Title: "Sprint 12", State: 3},
+		{ID: 6, GroupID: 3, Title: "Sprint 13", State: 2},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/api/v4/projects/42/iterations" || q.Get("state") != "all" || q.Get("include_ancestors") != "true" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(iterations)
+	}))
+	defer srv.Close()
+
+	client := gitlab.NewClient(srv.URL, "token")
+
+	iterationProject = "42"
+	for ref, want := range map[string]int{"6": 6, "Sprint 12": 5} {
+		it, err := resolveIteration(client, ref)
+		if err != nil {
+			t.Fatalf("%s: %v", ref, err)
+		}
+		if it.ID != want {
+			t.Errorf("%s resolved to %d, want %d", ref, it.ID, want)
+		}
+	}
+	if _, err := resolveIteration(client, "Sprint 1"); err == nil {
+		t.Error("expected an error for an unknown title")
+	}
+}
+
+func TestIterationTitle(t *testing.T) {
+	if got := iterationTitle(gitlab.Iteration{Title: "Sprint 12"}); got != "Sprint 12" {
+		t.Errorf("titled: %s", got)
+	}
+	if got := iterationTitle(gitlab.Iteration{StartDate: "2024-05-01", DueDate: "2024-05-14"}); got != "2024-05-01 – 2024-05-14" {
+		t.Errorf("untitled: %s", got)
+	}
+}
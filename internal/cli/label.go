@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/user/gitlab-cli/internal/config"
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"go.yaml.in/yaml/v3"
 )
 
 var labelCmd = &cobra.Command{
@@ -18,24 +23,119 @@ var labelCmd = &cobra.Command{
 
 var labelListCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List project labels",
+	Short: "List project or group labels",
 	RunE:  runLabelList,
 }
 
+var labelCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a label",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runLabelCreate,
+}
+
+var labelUpdateCmd = &cobra.Command{
+	Use:   "update <name>",
+	Short: "Update a label's name, color or description",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runLabelUpdate,
+}
+
+var labelDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a label",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runLabelDelete,
+}
+
+var labelSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Copy the labels of one project to other projects",
+	Long: `Copy the labels defined on a source project to one or more target projects.
+
+Targets are project IDs or paths, or globs over project paths such as
+"group/*" (direct projects) or "group/*/*" (projects one subgroup down).
+Labels missing from a target are created and labels whose color or
+description differ are updated. Target labels absent from the source are
+only deleted with --prune.`,
+	RunE: runLabelSync,
+}
+
+var labelExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export labels to YAML",
+	RunE:  runLabelExport,
+}
+
+var labelImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create or update labels from a YAML file",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runLabelImport,
+}
+
 var (
 	labelProject string
+	labelGroup   string
 	labelSearch  string
 	labelJSON    bool
+	labelSubs    bool
+
+	// label create/update flags
+	labelColor       string
+	labelDescription string
+	labelNewName     string
+
+	// label sync/import flags
+	labelFrom  string
+	labelTo    []string
+	labelPrune bool
+	labelYes   bool
+
+	// label export flags
+	labelOutput string
 )
 
 func init() {
 	rootCmd.AddCommand(labelCmd)
 	labelCmd.AddCommand(labelListCmd)
+	labelCmd.AddCommand(labelCreateCmd)
+	labelCmd.AddCommand(labelUpdateCmd)
+	labelCmd.AddCommand(labelDeleteCmd)
+	labelCmd.AddCommand(labelSyncCmd)
+	labelCmd.AddCommand(labelExportCmd)
+	labelCmd.AddCommand(labelImportCmd)
+
+	for _, cmd := range []*cobra.Command{labelListCmd, labelCreateCmd, labelUpdateCmd, labelDeleteCmd, labelExportCmd, labelImportCmd} {
+		cmd.Flags().StringVar(&labelProject, "project", "", "project ID or path")
+		cmd.Flags().StringVar(&labelGroup, "group", "", "group ID or path")
+		cmd.MarkFlagsOneRequired("project", "group")
+		cmd.MarkFlagsMutuallyExclusive("project", "group")
+	}
 
-	labelListCmd.Flags().StringVar(&labelProject, "project", "", "project ID or path (required)")
 	labelListCmd.Flags().StringVar(&labelSearch, "search", "", "filter labels by name")
 	labelListCmd.Flags().BoolVar(&labelJSON, "json", false, "output as JSON")
-	labelListCmd.MarkFlagRequired("project")
+	labelListCmd.Flags().BoolVar(&labelSubs, "include-subgroups", false, "with --group, include labels of subgroups")
+
+	labelCreateCmd.Flags().StringVar(&labelColor, "color", "", "label color, e.g. #d9534f (required)")
+	labelCreateCmd.Flags().StringVar(&labelDescription, "description", "", "label description")
+	labelCreateCmd.MarkFlagRequired("color")
+
+	labelUpdateCmd.Flags().StringVar(&labelNewName, "name", "", "new label name")
+	labelUpdateCmd.Flags().StringVar(&labelColor, "color", "", "new label color")
+	labelUpdateCmd.Flags().StringVar(&labelDescription, "description", "", "new label description (empty to clear)")
+
+	labelSyncCmd.Flags().StringVar(&labelFrom, "from", "", "source project ID or path (required)")
+	labelSyncCmd.Flags().StringSliceVar(&labelTo, "to", nil, "target project or project-path glob (repeatable, required)")
+	labelSyncCmd.MarkFlagRequired("from")
+	labelSyncCmd.MarkFlagRequired("to")
+
+	for _, cmd := range []*cobra.Command{labelSyncCmd, labelImportCmd} {
+		cmd.Flags().BoolVar(&labelPrune, "prune", false, "delete target labels that are not in the source")
+		cmd.Flags().BoolVarP(&labelYes, "yes", "y", false, "skip confirmation prompt")
+	}
+
+	labelExportCmd.Flags().StringVarP(&labelOutput, "output", "o", "", "write to file instead of stdout")
 }
 
 func runLabelList(cmd *cobra.Command, args []string) error {
@@ -48,9 +148,17 @@ func runLabelList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
-	labels, err := client.ListProjectLabels(labelProject, labelSearch)
+	var labels []gitlab.Label
+	if labelGroup != "" {
+		labels, err = client.ListGroupLabels(labelGroup, labelSearch, labelSubs)
+	} else {
+		if labelSubs {
+			return fmt.Errorf("--include-subgroups requires --group")
+		}
+		labels, err = client.ListProjectLabels(labelProject, labelSearch)
+	}
 	if err != nil {
 		return err
 	}
@@ -80,3 +188,445 @@ func runLabelList(cmd *cobra.Command, args []string) error {
 	w.Flush()
 	return nil
 }
+
+func runLabelCreate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	label, err := client.CreateLabel(labelProject, labelGroup, gitlab.CreateLabelOptions{
+		Name:        args[0],
+		Color:       labelColor,
+		Description: labelDescription,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created label %s (%s)\n", label.Name, label.Color)
+	return nil
+}
+
+func runLabelUpdate(cmd *cobra.Command, args []string) error {
+	opts := gitlab.UpdateLabelOptions{
+		NewName: labelNewName,
+		Color:   labelColor,
+	}
+	if cmd.Flags().Changed("description") {
+		opts.Description = &labelDescription
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	label, err := client.UpdateLabel(labelProject, labelGroup, args[0], opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Updated label %s (%s)\n", label.Name, label.Color)
+	return nil
+}
+
+func runLabelDelete(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	if err := client.DeleteLabel(labelProject, labelGroup, args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted label %s\n", args[0])
+	return nil
+}
+
+func runLabelSync(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	source, err := client.ListOwnLabels(labelFrom, "")
+	if err != nil {
+		return err
+	}
+
+	projects, err := resolveLabelTargets(client, labelFrom, labelTo)
+	if err != nil {
+		return err
+	}
+
+	targets := make([]labelTarget, len(projects))
+	for i, p := range projects {
+		targets[i] = labelTarget{ProjectID: p}
+	}
+
+	return reconcileLabels(client, source, targets)
+}
+
+func runLabelExport(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	labels, err := client.ListOwnLabels(labelProject, labelGroup)
+	if err != nil {
+		return err
+	}
+
+	data, err := marshalLabelFile(labels)
+	if err != nil {
+		return err
+	}
+
+	if labelOutput == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(labelOutput, data, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", labelOutput, err)
+	}
+	fmt.Printf("Exported %d label(s) to %s\n", len(labels), labelOutput)
+	return nil
+}
+
+func runLabelImport(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+
+	desired, err := parseLabelFile(data)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", args[0], err)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	return reconcileLabels(client, desired, []labelTarget{{ProjectID: labelProject, GroupID: labelGroup}})
+}
+
+// labelTarget is a project or group whose labels are reconciled.
+type labelTarget struct {
+	ProjectID string
+	GroupID   string
+}
+
+func (t labelTarget) String() string {
+	if t.GroupID != "" {
+		return "group " + t.GroupID
+	}
+	return t.ProjectID
+}
+
+// reconcileLabels brings the labels of each target in line with desired,
+// honouring --prune, --dry-run and --yes.
+func reconcileLabels(client *gitlab.Client, desired []gitlab.Label, targets []labelTarget) error {
+	plans := make([][]labelChange, len(targets))
+	total := 0
+
+	for i, target := range targets {
+		existing, err := client.ListOwnLabels(target.ProjectID, target.GroupID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", target, err)
+		}
+
+		plans[i] = planLabelChanges(desired, existing, labelPrune)
+		total += len(plans[i])
+
+		fmt.Printf("── %s ──\n", target)
+		if len(plans[i]) == 0 {
+			fmt.Println("  up to date")
+			continue
+		}
+		for _, change := range plans[i] {
+			fmt.Printf("  %s\n", change)
+		}
+	}
+	fmt.Println()
+
+	if total == 0 {
+		fmt.Println("Labels are in sync")
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: would apply %d change(s) to %d target(s)\n", total, len(targets))
+		return nil
+	}
+
+	if !labelYes && !confirmPrompt(fmt.Sprintf("Apply %d label change(s) to %d target(s)?", total, len(targets))) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	failed := 0
+	for i, target := range targets {
+		for _, change := range plans[i] {
+			if err := applyLabelChange(client, target, change); err != nil {
+				fmt.Printf("✗ %s: %s: %v\n", target, change.Label.Name, err)
+				failed++
+			}
+		}
+	}
+
+	fmt.Printf("Applied %d of %d change(s)\n", total-failed, total)
+	if failed > 0 {
+		return fmt.Errorf("%d label change(s) failed", failed)
+	}
+	return nil
+}
+
+func applyLabelChange(client *gitlab.Client, target labelTarget, change labelChange) error {
+	switch change.Action {
+	case labelActionAdd:
+		_, err := client.CreateLabel(target.ProjectID, target.GroupID, gitlab.CreateLabelOptions{
+			Name:        change.Label.Name,
+			Color:       change.Label.Color,
+			Description: change.Label.Description,
+		})
+		return err
+	case labelActionChange:
+		_, err := client.UpdateLabel(target.ProjectID, target.GroupID, change.Label.Name, gitlab.UpdateLabelOptions{
+			Color:       change.Label.Color,
+			Description: &change.Label.Description,
+		})
+		return err
+	case labelActionRemove:
+		return client.DeleteLabel(target.ProjectID, target.GroupID, change.Label.Name)
+	}
+	return fmt.Errorf("unknown label action %q", change.Action)
+}
+
+const (
+	labelActionAdd    = "add"
+	labelActionChange = "change"
+	labelActionRemove = "remove"
+)
+
+// labelChange is one step needed to make a target's labels match the source.
+// For changes, Old holds the target's current label.
+type labelChange struct {
+	Action string
+	Label  gitlab.Label
+	Old    gitlab.Label
+}
+
+func (c labelChange) String() string {
+	switch c.Action {
+	case labelActionAdd:
+		return fmt.Sprintf("+ %s (%s)", c.Label.Name, c.Label.Color)
+	case labelActionRemove:
+		return fmt.Sprintf("- %s", c.Label.Name)
+	}
+
+	var diffs []string
+	if !strings.EqualFold(c.Old.Color, c.Label.Color) {
+		diffs = append(diffs, fmt.Sprintf("color %s → %s", c.Old.Color, c.Label.Color))
+	}
+	if c.Old.Description != c.Label.Description {
+		diffs = append(diffs, "description updated")
+	}
+	return fmt.Sprintf("~ %s: %s", c.Label.Name, strings.Join(diffs, ", "))
+}
+
+// planLabelChanges compares labels by name and returns the additions and
+// color/description changes needed to make existing match desired, followed
+// by removals of extra labels when prune is set. Each group is sorted by name.
+func planLabelChanges(desired, existing []gitlab.Label, prune bool) []labelChange {
+	current := make(map[string]gitlab.Label, len(existing))
+	for _, l := range existing {
+		current[l.Name] = l
+	}
+	wanted := make(map[string]bool, len(desired))
+
+	var adds, updates, removes []labelChange
+	for _, l := range desired {
+		wanted[l.Name] = true
+		old, ok := current[l.Name]
+		switch {
+		case !ok:
+			adds = append(adds, labelChange{Action: labelActionAdd, Label: l})
+		case !strings.EqualFold(old.Color, l.Color) || old.Description != l.Description:
+			updates = append(updates, labelChange{Action: labelActionChange, Label: l, Old: old})
+		}
+	}
+
+	if prune {
+		for _, l := range existing {
+			if !wanted[l.Name] {
+				removes = append(removes, labelChange{Action: labelActionRemove, Label: l})
+			}
+		}
+	}
+
+	var changes []labelChange
+	for _, group := range [][]labelChange{adds, updates, removes} {
+		sort.Slice(group, func(i, j int) bool { return group[i].Label.Name < group[j].Label.Name })
+		changes = append(changes, group...)
+	}
+	return changes
+}
+
+// resolveLabelTargets expands the --to values into project paths or IDs.
+// Values containing glob characters are matched against the paths of the
+// projects in the glob's leading group, skipping archived projects and the
+// source project.
+func resolveLabelTargets(client *gitlab.Client, source string, refs []string) ([]string, error) {
+	seen := map[string]bool{source: true}
+	var targets []string
+
+	for _, ref := range refs {
+		ref = strings.Trim(ref, "/")
+		if !hasGlobMeta(ref) {
+			if !seen[ref] {
+				seen[ref] = true
+				targets = append(targets, ref)
+			}
+			continue
+		}
+
+		group := globBaseGroup(ref)
+		if group == "" {
+			return nil, fmt.Errorf("target %q must start with a group path, e.g. group/*", ref)
+		}
+
+		projects, err := client.ListGroupProjects(group, true)
+		if err != nil {
+			return nil, err
+		}
+
+		matched := 0
+		for _, p := range projects {
+			if p.Archived {
+				continue
+			}
+			if ok, _ := path.Match(ref, p.PathWithNamespace); !ok {
+				continue
+			}
+			matched++
+			if seen[p.PathWithNamespace] || seen[strconv.Itoa(p.ID)] {
+				continue
+			}
+			seen[p.PathWithNamespace] = true
+			targets = append(targets, p.PathWithNamespace)
+		}
+		if matched == 0 {
+			return nil, fmt.Errorf("no projects match %q", ref)
+		}
+	}
+
+	return targets, nil
+}
+
+func hasGlobMeta(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+// globBaseGroup returns the path segments of a glob before the first segment
+// containing a glob character.
+func globBaseGroup(pattern string) string {
+	var base []string
+	for _, seg := range strings.Split(pattern, "/") {
+		if hasGlobMeta(seg) {
+			break
+		}
+		base = append(base, seg)
+	}
+	return strings.Join(base, "/")
+}
+
+// labelFile is the YAML format used by label export and import.
+type labelFile struct {
+	Labels []labelSpec `yaml:"labels"`
+}
+
+type labelSpec struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description,omitempty"`
+}
+
+func marshalLabelFile(labels []gitlab.Label) ([]byte, error) {
+	file := labelFile{Labels: make([]labelSpec, len(labels))}
+	for i, l := range labels {
+		file.Labels[i] = labelSpec{Name: l.Name, Color: l.Color, Description: l.Description}
+	}
+	sort.Slice(file.Labels, func(i, j int) bool { return file.Labels[i].Name < file.Labels[j].Name })
+
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return nil, fmt.Errorf("encoding labels: %w", err)
+	}
+	return data, nil
+}
+
+func parseLabelFile(data []byte) ([]gitlab.Label, error) {
+	var file labelFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	labels := make([]gitlab.Label, 0, len(file.Labels))
+	for i, spec := range file.Labels {
+		if spec.Name == "" {
+			return nil, fmt.Errorf("label %d: name is required", i+1)
+		}
+		if spec.Color == "" {
+			return nil, fmt.Errorf("label %q: color is required", spec.Name)
+		}
+		if seen[spec.Name] {
+			return nil, fmt.Errorf("label %q: defined more than once", spec.Name)
+		}
+		seen[spec.Name] = true
+		labels = append(labels, gitlab.Label{Name: spec.Name, Color: spec.Color, Description: spec.Description})
+	}
+	return labels, nil
+}
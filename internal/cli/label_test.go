@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestPlanLabelChanges(t *testing.T) {
+	desired := []gitlab.Label{
+		{Name: "bug", Color: "#d9534f", Description: "Something broke"},
+		{Name: "feature", Color: "#5cb85c"},
+		{Name: "docs", Color: "#428bca", Description: "Documentation"},
+		{Name: "chore", Color: "#AAAAAA"},
+	}
+	existing := []gitlab.Label{
+		{Name: "bug", Color: "#ff0000", Description: "Something broke"},
+		{Name: "docs", Color: "#428bca", Description: "Docs"},
+		{Name: "chore", Color: "#aaaaaa"},
+		{Name: "wontfix", Color: "#ffffff"},
+	}
+
+	tests := []struct {
+		name  string
+		prune bool
+		want  []string
+	}{
+		{
+			name: "without prune",
+			want: []string{
+				"+ feature (#5cb85c)",
+				"~ bug: color #ff0000 → #d9534f",
+				"~ docs: description updated",
+			},
+		},
+		{
+			name:  "with prune",
+			prune: true,
+			want: []string{
+				"+ feature (#5cb85c)",
+				"~ bug: color #ff0000 → #d9534f",
+				"~ docs: description updated",
+				"- wontfix",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := planLabelChanges(desired, existing, tt.prune)
+
+			got := make([]string, len(changes))
+			for i, c := range changes {
+				got[i] = c.String()
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("changes =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestPlanLabelChangesInSync(t *testing.T) {
+	labels := []gitlab.Label{{Name: "bug", Color: "#d9534f"}}
+	if changes := planLabelChanges(labels, labels, true); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestGlobBaseGroup(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"group/*", "group"},
+		{"group/sub/*", "group/sub"},
+		{"group/*/api-*", "group"},
+		{"team-?/*", ""},
+		{"*", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := globBaseGroup(tt.pattern); got != tt.want {
+				t.Errorf("globBaseGroup(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLabelFileRoundTrip(t *testing.T) {
+	labels := []gitlab.Label{
+		{ID: 2, Name: "feature", Color: "#5cb85c"},
+		{ID: 1, Name: "bug", Color: "#d9534f", Description: "Something broke"},
+	}
+
+	data, err := marshalLabelFile(labels)
+	if err != nil {
+		t.Fatalf("marshalLabelFile() error = %v", err)
+	}
+	if !strings.HasPrefix(string(data), "labels:\n    - name: bug\n") {
+		t.Errorf("unexpected YAML:\n%s", data)
+	}
+
+	got, err := parseLabelFile(data)
+	if err != nil {
+		t.Fatalf("parseLabelFile() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "bug" || got[0].Description != "Something broke" || got[1].Color != "#5cb85c" {
+		t.Errorf("round trip = %+v", got)
+	}
+}
+
+func TestParseLabelFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"missing name", "labels:\n  - color: '#fff'\n", "name is required"},
+		{"missing color", "labels:\n  - name: bug\n", "color is required"},
+		{"duplicate", "labels:\n  - {name: bug, color: '#fff'}\n  - {name: bug, color: '#000'}\n", "more than once"},
+		{"invalid yaml", "labels: [", "yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseLabelFile([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/metrics"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+// noTeam groups the MRs of authors outside every configured team.
+const noTeam = "(no team)"
+
+var metricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Engineering metrics",
+}
+
+var metricsMRCmd = &cobra.Command{
+	Use:   "mr",
+	Short: "Report review latency, cycle time and size of merged MRs",
+	Long: `Report on the MRs merged in the --since period, per author or, with
+--by team, per team of the "teams" config:
+
+  first review  time until someone other than the author or a bot
+                commented, approved or requested changes
+  approval      time until the last approval before the merge
+  merge         time from creation to merge
+  rebases       pushes onto a new target branch base
+  reruns        pipelines run again for the same commit
+  size          lines changed, and MRs per size class
+                (XS <= 10, S <= 50, M <= 250, L <= 1000, XL)
+
+Review and approval times count from when a draft was marked ready. Times
+are reported as nearest-rank percentiles; in CSV and JSON in hours.`,
+	RunE: runMetricsMR,
+}
+
+var (
+	metricsProject int
+	metricsGroup   string
+	metricsSince   string
+	metricsBy      string
+	metricsTeam    string
+	metricsFormat  string
+)
+
+func init() {
+	rootCmd.AddCommand(metricsCmd)
+	metricsCmd.AddCommand(metricsMRCmd)
+
+	metricsMRCmd.Flags().IntVar(&metricsProject, "project", 0, "MRs of project ID")
+	metricsMRCmd.Flags().StringVar(&metricsGroup, "group", "", "MRs of a group and its subgroups")
+	metricsMRCmd.Flags().StringVar(&metricsSince, "since", "30d", "MRs merged from this long ago until today, e.g. 10d, 2w, 3m")
+	metricsMRCmd.Flags().StringVar(&metricsBy, "by", "author", "group by: author, team")
+	metricsMRCmd.Flags().StringVar(&metricsTeam, "team", "", "only MRs by members of a configured team, team file or group")
+	metricsMRCmd.Flags().StringVar(&metricsFormat, "format", "table", "output format: table, csv, json")
+}
+
+func runMetricsMR(cmd *cobra.Command, args []string) error {
+	if metricsProject == 0 && metricsGroup == "" {
+		return fmt.Errorf("select MRs with --project or --group")
+	}
+	switch metricsBy {
+	case "author", "team":
+	default:
+		return fmt.Errorf("invalid --by '%s' (use author or team)", metricsBy)
+	}
+	switch metricsFormat {
+	case "table", "csv", "json":
+	default:
+		return fmt.Errorf("invalid format '%s' (use table, csv or json)", metricsFormat)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if metricsBy == "team" && len(cfg.Teams) == 0 {
+		return fmt.Errorf("--by team needs teams in the config")
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+	rng, err := timerange.Since(time.Now().In(loc), metricsSince)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	var authors []string
+	if metricsTeam != "" {
+		if authors, err = resolveTeam(cfg, client, metricsTeam); err != nil {
+			return err
+		}
+	}
+
+	// An MR merged in the range was last updated in it too
+	listed, err := client.ListAllMRs(gitlab.ListMROptions{
+		State:        "merged",
+		ProjectID:    metricsProject,
+		GroupID:      metricsGroup,
+		UpdatedAfter: rng.From.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	var merged []gitlab.MergeRequest
+	for _, mr := range listed {
+		mergedAt, err := time.Parse(time.RFC3339, mr.MergedAt)
+		if err != nil || !rng.Contains(mergedAt) {
+			continue
+		}
+		if authors != nil && !containsFold(authors, mr.Author.Username) {
+			continue
+		}
+		merged = append(merged, mr)
+	}
+
+	measured, err := metrics.MeasureAll(client, merged, 4)
+	if err != nil {
+		return err
+	}
+
+	groups := func(m metrics.MR) []string { return []string{m.Author} }
+	if metricsBy == "team" {
+		groups = func(m metrics.MR) []string { return authorTeams(cfg.Teams, m.Author) }
+	}
+	stats := metrics.GroupBy(measured, groups)
+	total := metrics.Summarize("(all)", measured)
+
+	switch metricsFormat {
+	case "csv":
+		return writeMetricsCSV(os.Stdout, append(stats, total))
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			From   string          `json:"from"`
+			To     string          `json:"to"`
+			By     string          `json:"by"`
+			Groups []metrics.Stats `json:"groups"`
+			Total  metrics.Stats   `json:"total"`
+		}{rng.FromDate(), rng.ToDate(), metricsBy, stats, total})
+	}
+
+	fmt.Printf("MRs merged %s to %s: %d\n\n", rng.FromDate(), rng.ToDate(), len(measured))
+	if len(measured) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(metrics.SizeClasses))
+	for i, c := range metrics.SizeClasses {
+		names[i] = c.Name
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tMRS\tFIRST REVIEW p50/p90\tAPPROVAL p50/p90\tMERGE p50/p90\tREBASES\tRERUNS\tSIZE p50\t%s\n",
+		strings.ToUpper(metricsBy), strings.Join(names, "/"))
+	for _, s := range append(stats, total) {
+		counts := make([]string, len(names))
+		for i, n := range names {
+			counts[i] = strconv.Itoa(s.Sizes[n])
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%d\t%d\t%.0f\t%s\n",
+			s.Group, s.MRs, formatLatency(s.FirstReview), formatLatency(s.Approval), formatLatency(s.Merge),
+			s.Rebases, s.Reruns, s.Size.P50, strings.Join(counts, "/"))
+	}
+	w.Flush()
+
+	return nil
+}
+
+// authorTeams returns the configured teams the author belongs to.
+func authorTeams(teams map[string]config.Team, author string) []string {
+	var names []string
+	for name, t := range teams {
+		if containsFold(t.Usernames(), author) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return []string{noTeam}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// formatLatency formats the median and 90th percentile of hours.
+func formatLatency(p metrics.Percentiles) string {
+	if p.N == 0 {
+		return "-"
+	}
+	return formatDurationHours(p.P50) + " / " + formatDurationHours(p.P90)
+}
+
+// formatDurationHours formats hours as minutes, hours or days, whichever
+// reads best.
+func formatDurationHours(h float64) string {
+	switch {
+	case h < 1:
+		return fmt.Sprintf("%.0fm", h*60)
+	case h < 48:
+		return fmt.Sprintf("%.1fh", h)
+	}
+	return fmt.Sprintf("%.1fd", h/24)
+}
+
+func writeMetricsCSV(out io.Writer, stats []metrics.Stats) error {
+	w := csv.NewWriter(out)
+	defer w.Flush()
+
+	header := []string{"group", "mrs"}
+	for _, m := range []string{"first_review_hours", "approval_hours", "merge_hours", "size_lines"} {
+		header = append(header, m+"_n", m+"_p50", m+"_p75", m+"_p90")
+	}
+	header = append(header, "rebases", "pipeline_reruns")
+	for _, c := range metrics.SizeClasses {
+		header = append(header, "size_"+strings.ToLower(c.Name))
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, s := range stats {
+		row := []string{s.Group, strconv.Itoa(s.MRs)}
+		for _, p := range []metrics.Percentiles{s.FirstReview, s.Approval, s.Merge, s.Size} {
+			row = append(row, strconv.Itoa(p.N), formatFloat(p.P50), formatFloat(p.P75), formatFloat(p.P90))
+		}
+		row = append(row, strconv.Itoa(s.Rebases), strconv.Itoa(s.Reruns))
+		for _, c := range metrics.SizeClasses {
+			row = append(row, strconv.Itoa(s.Sizes[c.Name]))
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/metrics"
+)
+
+func TestAuthorTeams(t *testing.T) {
+	teams := map[string]config.Team{
+		"backend":  {Members: []config.TeamMember{{Username: "@alice"}, {Username: "bob"}}},
+		"platform": {Members: []config.TeamMember{{Username: "Alice"}}},
+	}
+	if got := authorTeams(teams, "alice"); !slices.Equal(got, []string{"backend", "platform"}) {
+		t.Errorf("alice: %v", got)
+	}
+	if got := authorTeams(teams, "carol"); !slices.Equal(got, []string{noTeam}) {
+		t.Errorf("carol: %v", got)
+	}
+}
+
+func TestFormatDurationHours(t *testing.T) {
+	tests := map[float64]string{0.25: "15m", 3.5: "3.5h", 47.9: "47.9h", 72: "3.0d"}
+	for h, want := range tests {
+		if got := formatDurationHours(h); got != want {
+			t.Errorf("formatDurationHours(%v) = %s, want %s", h, got, want)
+		}
+	}
+	if got := formatLatency(metrics.Percentiles{}); got != "-" {
+		t.Errorf("no values: %s", got)
+	}
+}
+
+func TestWriteMetricsCSV(t *testing.T) {
+	stats := []metrics.Stats{metrics.Summarize("alice", []metrics.MR{
+		{FirstReview: 90 * time.Minute, Approval: -1, Merge: 3 * time.Hour, Size: 40, Rebases: 2},
+	})}
+
+	var buf bytes.Buffer
+	if err := writeMetricsCSV(&buf, stats); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "group,mrs,first_review_hours_n,first_review_hours_p50,") || !strings.HasSuffix(lines[0], "size_l,size_xl") {
+		t.Errorf("header = %s", lines[0])
+	}
+	want := "alice,1,1,1.50,1.50,1.50,0,0.00,0.00,0.00,1,3.00,3.00,3.00,1,40.00,40.00,40.00,2,0,0,1,0,0,0"
+	if lines[1] != want {
+		t.Errorf("row = %s\nwant  %s", lines[1], want)
+	}
+}
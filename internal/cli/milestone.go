@@ -0,0 +1,385 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+var milestoneCmd = &cobra.Command{
+	Use:   "milestone",
+	Short: "Milestone operations for projects and groups",
+}
+
+var milestoneListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List milestones",
+	RunE:  runMilestoneList,
+}
+
+var milestoneShowCmd = &cobra.Command{
+	Use:   "show <id|title>",
+	Short: "Show a milestone with its MRs and issues rolled up by state",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMilestoneShow,
+}
+
+var milestoneCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a milestone",
+	RunE:  runMilestoneCreate,
+}
+
+var milestoneCloseCmd = &cobra.Command{
+	Use:   "close <id|title>",
+	Short: "Close a milestone",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMilestoneClose,
+}
+
+var (
+	milestoneProject string
+	milestoneGroup   string
+	milestoneJSON    bool
+
+	// milestone list flags
+	milestoneState  string
+	milestoneSearch string
+
+	// milestone create flags
+	milestoneTitle       string
+	milestoneDescription string
+	milestoneStart       string
+	milestoneDue         string
+)
+
+func init() {
+	rootCmd.AddCommand(milestoneCmd)
+	milestoneCmd.AddCommand(milestoneListCmd)
+	milestoneCmd.AddCommand(milestoneShowCmd)
+	milestoneCmd.AddCommand(milestoneCreateCmd)
+	milestoneCmd.AddCommand(milestoneCloseCmd)
+
+	milestoneCmd.PersistentFlags().StringVar(&milestoneProject, "project", "", "project ID or path")
+	milestoneCmd.PersistentFlags().StringVar(&milestoneGroup, "group", "", "group ID or path")
+	milestoneCmd.MarkFlagsOneRequired("project", "group")
+	milestoneCmd.MarkFlagsMutuallyExclusive("project", "group")
+
+	milestoneListCmd.Flags().StringVar(&milestoneState, "state", "active", "milestone state: active, closed, or all")
+	milestoneListCmd.Flags().StringVar(&milestoneSearch, "search", "", "filter by title or description")
+	milestoneListCmd.Flags().BoolVar(&milestoneJSON, "json", false, "output as JSON")
+
+	milestoneShowCmd.Flags().BoolVar(&milestoneJSON, "json", false, "output as JSON")
+
+	milestoneCreateCmd.Flags().StringVar(&milestoneTitle, "title", "", "milestone title (required)")
+	milestoneCreateCmd.Flags().StringVar(&milestoneDescription, "description", "", "milestone description")
+	milestoneCreateCmd.Flags().StringVar(&milestoneStart, "start", "", "start date (YYYY-MM-DD)")
+	milestoneCreateCmd.Flags().StringVar(&milestoneDue, "due", "", "due date (YYYY-MM-DD)")
+	milestoneCreateCmd.MarkFlagRequired("title")
+}
+
+func runMilestoneList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	milestones, err := client.ListMilestones(gitlab.ListMilestonesOptions{
+		ProjectID: milestoneProject,
+		GroupID:   milestoneGroup,
+		State:     milestoneState,
+		Search:    milestoneSearch,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(milestones) == 0 {
+		fmt.Println("No milestones found")
+		return nil
+	}
+
+	if milestoneJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(milestones)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSTATE\tSTART\tDUE\tSCOPE")
+
+	for _, m := range milestones {
+		state := m.State
+		if m.Expired && m.State == "active" {
+			state = "expired"
+		}
+		scope := "project"
+		if m.GroupID != 0 {
+			scope = "group"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			m.ID, truncate(m.Title, 40), state, dashIfEmpty(m.StartDate), dashIfEmpty(m.DueDate), scope)
+	}
+
+	w.Flush()
+	return nil
+}
+
+func runMilestoneShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	milestone, err := resolveMilestone(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	project, group := milestoneScope(milestone)
+	mrs, err := client.GetMilestoneMRs(project, group, milestone.ID)
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.GetMilestoneIssues(project, group, milestone.ID)
+	if err != nil {
+		return err
+	}
+
+	mrStates := make([]string, len(mrs))
+	for i, mr := range mrs {
+		mrStates[i] = mr.State
+	}
+	issueStates := make([]string, len(issues))
+	for i, issue := range issues {
+		issueStates[i] = issue.State
+	}
+	mrRollup := rollupByState(mrStates)
+	issueRollup := rollupByState(issueStates)
+
+	if milestoneJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			*gitlab.Milestone
+			MergeRequests map[string]int `json:"merge_requests"`
+			Issues        map[string]int `json:"issues"`
+		}{milestone, mrRollup, issueRollup})
+	}
+
+	fmt.Printf("Milestone: %s\n", milestone.Title)
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("State:        %s\n", milestone.State)
+	if milestone.StartDate != "" || milestone.DueDate != "" {
+		fmt.Printf("Dates:        %s → %s\n", dashIfEmpty(milestone.StartDate), dashIfEmpty(milestone.DueDate))
+	}
+	if milestone.Expired && milestone.State == "active" {
+		fmt.Println("Expired:      yes (past due date)")
+	}
+	fmt.Printf("URL:          %s\n", milestone.WebURL)
+	fmt.Printf("MRs:          %s\n", formatRollup(mrRollup, []string{"opened", "merged", "closed"}))
+	fmt.Printf("Issues:       %s\n", formatRollup(issueRollup, []string{"opened", "closed"}))
+
+	done := mrRollup["merged"] + mrRollup["closed"] + issueRollup["closed"]
+	total := len(mrs) + len(issues)
+	if total > 0 {
+		fmt.Printf("Progress:     %d%% (%d of %d done)\n", done*100/total, done, total)
+	}
+
+	var openMRs []gitlab.MergeRequest
+	for _, mr := range mrs {
+		if mr.State == "opened" {
+			openMRs = append(openMRs, mr)
+		}
+	}
+	if len(openMRs) > 0 {
+		fmt.Println()
+		fmt.Println("── Open merge requests ──")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tIID\tPROJECT\tAUTHOR\tTITLE")
+		for _, mr := range openMRs {
+			fmt.Fprintf(w, "%d\t!%d\t%d\t%s\t%s\n", mr.ID, mr.IID, mr.ProjectID, mr.Author.Username, truncate(mr.Title, 50))
+		}
+		w.Flush()
+	}
+
+	var openIssues []gitlab.Issue
+	for _, issue := range issues {
+		if issue.State == "opened" {
+			openIssues = append(openIssues, issue)
+		}
+	}
+	if len(openIssues) > 0 {
+		fmt.Println()
+		fmt.Println("── Open issues ──")
+		printIssueTable(openIssues)
+	}
+
+	return nil
+}
+
+func runMilestoneCreate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	milestone, err := client.CreateMilestone(milestoneProject, milestoneGroup, gitlab.CreateMilestoneOptions{
+		Title:       milestoneTitle,
+		Description: milestoneDescription,
+		StartDate:   milestoneStart,
+		DueDate:     milestoneDue,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created milestone %s (ID %d)\n", milestone.Title, milestone.ID)
+	fmt.Printf("URL: %s\n", milestone.WebURL)
+	return nil
+}
+
+func runMilestoneClose(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	milestone, err := resolveMilestone(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	project, group := milestoneScope(milestone)
+	closed, err := client.UpdateMilestoneState(project, group, milestone.ID, "close")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Closed milestone %s\n", closed.Title)
+	return nil
+}
+
+// resolveMilestone finds a milestone of the selected project or group by
+// numeric ID or exact title.
+func resolveMilestone(client *gitlab.Client, ref string) (*gitlab.Milestone, error) {
+	id, err := strconv.Atoi(ref)
+	if err == nil {
+		milestone, err := client.GetMilestone(milestoneProject, milestoneGroup, id)
+		var apiErr *gitlab.APIError
+		if milestoneProject == "" || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			return milestone, err
+		}
+		// The project endpoint does not know group milestones it inherits
+	}
+
+	opts := gitlab.ListMilestonesOptions{
+		ProjectID: milestoneProject,
+		GroupID:   milestoneGroup,
+	}
+	if id == 0 {
+		opts.Title = ref
+	}
+	milestones, err := client.ListMilestones(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range milestones {
+		if (id != 0 && milestones[i].ID == id) || (id == 0 && milestones[i].Title == ref) {
+			return &milestones[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("milestone '%s' not found", ref)
+}
+
+// milestoneScope returns the project or group that owns m. With --project,
+// m may be a milestone inherited from a group, which the project's
+// milestone endpoints do not know.
+func milestoneScope(m *gitlab.Milestone) (projectID, groupID string) {
+	if m.GroupID != 0 {
+		return "", strconv.Itoa(m.GroupID)
+	}
+	return milestoneProject, milestoneGroup
+}
+
+// rollupByState counts items per state.
+func rollupByState(states []string) map[string]int {
+	counts := make(map[string]int)
+	for _, s := range states {
+		counts[s]++
+	}
+	return counts
+}
+
+// formatRollup renders counts in the given state order, followed by any
+// other states in alphabetical order.
+func formatRollup(counts map[string]int, order []string) string {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+
+	seen := make(map[string]bool)
+	parts := make([]string, 0, len(counts))
+	for _, state := range order {
+		seen[state] = true
+		parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+	}
+
+	var extra []string
+	for state := range counts {
+		if !seen[state] {
+			extra = append(extra, state)
+		}
+	}
+	sort.Strings(extra)
+	for _, state := range extra {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+	}
+
+	return fmt.Sprintf("%d total (%s)", total, strings.Join(parts, ", "))
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
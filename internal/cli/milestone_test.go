@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestRollupByState(t *testing.T) {
+	got := rollupByState([]string{"opened", "merged", "opened", "closed", "locked"})
+
+	want := map[string]int{"opened": 2, "merged": 1, "closed": 1, "locked": 1}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for state, n := range want {
+		if got[state] != n {
+			t.Errorf("%s = %d, want %d", state, got[state], n)
+		}
+	}
+}
+
+func TestFormatRollup(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts map[string]int
+		order  []string
+		want   string
+	}{
+		{
+			name:   "all known states",
+			counts: map[string]int{"opened": 2, "merged": 3, "closed": 1},
+			order:  []string{"opened", "merged", "closed"},
+			want:   "6 total (2 opened, 3 merged, 1 closed)",
+		},
+		{
+			name:   "missing states shown as zero",
+			counts: map[string]int{"closed": 4},
+			order:  []string{"opened", "closed"},
+			want:   "4 total (0 opened, 4 closed)",
+		},
+		{
+			name:   "extra states sorted after order",
+			counts: map[string]int{"opened": 1, "locked": 1, "draft": 2},
+			order:  []string{"opened"},
+			want:   "4 total (1 opened, 2 draft, 1 locked)",
+		},
+		{
+			name:   "empty",
+			counts: map[string]int{},
+			order:  []string{"opened", "closed"},
+			want:   "0 total (0 opened, 0 closed)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatRollup(tt.counts, tt.order); got != tt.want {
+				t.Errorf("formatRollup() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunMilestoneCloseInherited(t *testing.T) {
+	defer func() { milestoneProject, cfgFile = "", "" }()
+
+	// v2.0 belongs to group 3 and is inherited by project 42
+	milestone := gitlab.Milestone{ID: 7, GroupID: 3, Title: "v2.0", State: "active"}
+	var closedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/42/milestones":
+			if r.URL.Query().Get("include_ancestors") != "true" {
+				t.Errorf("ancestors not included: %s", r.URL)
+			}
+			json.NewEncoder(w).Encode([]gitlab.Milestone{milestone})
+		case r.Method == http.MethodPut:
+			closedPath = r.URL.Path
+			milestone.State = "closed"
+			json.NewEncoder(w).Encode(milestone)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cfgFile = filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgFile, []byte("gitlab_url: "+srv.URL+"\ngitlab_token: token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	milestoneProject = "42"
+	if err := runMilestoneClose(milestoneCloseCmd, []string{"v2.0"}); err != nil {
+		t.Fatal(err)
+	}
+	if closedPath != "/api/v4/groups/3/milestones/7" {
+		t.Errorf("closed via %s, want the group milestone", closedPath)
+	}
+}
+
+func TestMilestoneScope(t *testing.T) {
+	defer func() { milestoneProject = "" }()
+	milestoneProject = "42"
+
+	if p, g := milestoneScope(&gitlab.Milestone{ID: 1, ProjectID: 42}); p != "42" || g != "" {
+		t.Errorf("project milestone: %q, %q", p, g)
+	}
+	if p, g := milestoneScope(&gitlab.Milestone{ID: 7, GroupID: 3}); p != "" || g != "3" {
+		t.Errorf("group milestone: %q, %q", p, g)
+	}
+}
+
+func TestResolveMilestoneInheritedID(t *testing.T) {
+	defer func() { milestoneProject = "" }()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/42/milestones":
+			json.NewEncoder(w).Encode([]gitlab.Milestone{
+				{ID: 1, ProjectID: 42, Title: "v1.0"},
+				{ID: 7, GroupID: 3, Title: "v2.0"},
+			})
+		default:
+			// Including /projects/42/milestones/7, which is a group milestone
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	milestoneProject = "42"
+	m, err := resolveMilestone(gitlab.NewClient(srv.URL, "token"), "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ID != 7 || m.GroupID != 3 {
+		t.Errorf("resolved %+v, want group milestone 7", m)
+	}
+
+	if _, err := resolveMilestone(gitlab.NewClient(srv.URL, "token"), "8"); err == nil {
+		t.Error("expected an error for an unknown ID")
+	}
+}
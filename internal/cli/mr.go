@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"slices"
+	"strconv"
 	"strings"
 	"syscall"
 	"text/tabwriter"
@@ -18,6 +19,7 @@ import (
 	"github.com/user/gitlab-cli/internal/gitlab"
 	"github.com/user/gitlab-cli/internal/mergeops"
 	"github.com/user/gitlab-cli/internal/progress"
+	"github.com/user/gitlab-cli/internal/webhook"
 )
 
 var mrCmd = &cobra.Command{
@@ -55,7 +57,25 @@ var mrMergeCmd = &cobra.Command{
 var mrCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a merge request",
-	RunE:  runMRCreate,
+	Long: `Create a merge request from --source into --target.
+
+Without --title, the title is the task number of the source branch followed
+by the title of its first commit since the target branch.
+
+--template fills the description from .gitlab/merge_request_templates/<name>.md,
+read from the local checkout when it is a clone of --project and from the
+project otherwise.
+These placeholders are replaced:
+
+  %{source_branch}  source branch name
+  %{target_branch}  target branch name
+  %{task}           task reference from the branch name, e.g. #12345
+  %{first_commit}   title of the first commit
+  %{all_commits}    one "- title (sha)" line per commit
+
+--edit opens $EDITOR with the title on the first line and the description
+below it.`,
+	RunE: runMRCreate,
 }
 
 var mrLabelCmd = &cobra.Command{
@@ -75,8 +95,15 @@ var mrAutoMergeCmd = &cobra.Command{
 var mrReviewerCmd = &cobra.Command{
 	Use:   "reviewer <mr-id>",
 	Short: "Manage reviewers on a merge request",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runMRReviewer,
+	Long: `Manage reviewers on a merge request.
+
+--suggest ranks the code owners of the changed files, read from the
+project's CODEOWNERS file on the target branch. Owners are scored by the
+number of changed files they own divided by one plus their review load (the
+other open MRs they are reviewing). Users whose status is busy or signals
+absence are ranked last. --auto N adds the top N available owners.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMRReviewer,
 }
 
 var mrAssigneeCmd = &cobra.Command{
@@ -93,8 +120,110 @@ var mrUpdateCmd = &cobra.Command{
 	RunE:  runMRUpdate,
 }
 
+var mrApproveCmd = &cobra.Command{
+	Use:   "approve <mr-id>",
+	Short: "Approve a merge request",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRApprove,
+}
+
+var mrUnapproveCmd = &cobra.Command{
+	Use:   "unapprove <mr-id>",
+	Short: "Revoke your approval of a merge request",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRUnapprove,
+}
+
+var mrApprovalsCmd = &cobra.Command{
+	Use:   "approvals <mr-id>",
+	Short: "Show approval rules and their status",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRApprovals,
+}
+
+var mrDiffCmd = &cobra.Command{
+	Use:   "diff <mr-id>",
+	Short: "Show the changes of a merge request",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRDiff,
+}
+
+var mrIssuesCmd = &cobra.Command{
+	Use:   "issues <mr-id>",
+	Short: "List issues the merge request closes when merged",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRIssues,
+}
+
+var mrCheckoutCmd = &cobra.Command{
+	Use:   "checkout <mr-id>",
+	Short: "Check out a merge request branch locally",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRCheckout,
+}
+
+var mrStackCmd = &cobra.Command{
+	Use:   "stack",
+	Short: "Create and maintain chains of dependent merge requests",
+	Long: `A stack is a chain of MRs where each one targets the source branch of the
+one below it. The order is recorded in a navigation block in every MR
+description, so any member identifies the whole stack.`,
+}
+
+var mrStackCreateCmd = &cobra.Command{
+	Use:   "create <branch>...",
+	Short: "Open MRs for branches from bottom to top, each targeting the previous one",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runMRStackCreate,
+}
+
+var mrStackSyncCmd = &cobra.Command{
+	Use:   "sync <mr-id>",
+	Short: "Retarget and rebase the open MRs of a stack after lower MRs merged",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRStackSync,
+}
+
+var mrStackShowCmd = &cobra.Command{
+	Use:   "show <mr-id>",
+	Short: "Show the MRs of a stack with their state",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runMRStackShow,
+}
+
+var mrBulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Apply changes to every MR matching filters or read from stdin",
+	Long: `Select merge requests with the mr list filters (--project, --group,
+--mine, --approved) or read identifiers from stdin with --stdin, one per
+line (the first column of mr list output works), then apply the requested
+changes to each of them concurrently.
+
+A preview of the selected MRs is shown and confirmation is asked before
+anything is changed.`,
+	RunE: runMRBulk,
+}
+
+var mrStaleCmd = &cobra.Command{
+	Use:   "stale",
+	Short: "Find stale merge requests and label, remind or close them",
+	Long: `Find open merge requests that had no activity (notes, pipelines or
+updates) for --days, drafts abandoned for --draft-days, and MRs more than
+--behind commits behind their target branch.
+
+Policies act on what is found: --label adds the stale label, --comment posts
+the reminder template (placeholders %{author}, %{days}, %{reasons},
+%{label}, %{grace_days}, %{target_branch}) once per idle spell, and --close
+closes idle MRs that were labelled or reminded at least --grace days ago
+without activity since. The label is removed again once an MR is active.
+MRs with an excluded label are skipped. Defaults come from the "stale"
+config section.`,
+	RunE: runMRStale,
+}
+
 var (
 	listProject     int
+	listGroup       string
 	listMine        bool
 	listApproved    bool
 	showJSON        bool
@@ -104,6 +233,7 @@ var (
 	mergeAutoRebase bool
 	mergeMaxRetries int
 	mergeTimeout    string
+	mergeWebhook    string
 	noCacheFlag     bool
 	selectIndex     int
 
@@ -119,6 +249,10 @@ var (
 	createAllowCollab        bool
 	createJSON               bool
 	createAssign             []string
+	createReviewersFrom      string
+	createCloses             []string
+	createTemplate           string
+	createEdit               bool
 
 	// mr label flags
 	labelAdd    []string
@@ -129,9 +263,11 @@ var (
 	autoMergeCancel bool
 
 	// mr reviewer flags
-	reviewerAdd    []string
-	reviewerRemove []string
-	reviewerList   bool
+	reviewerAdd     []string
+	reviewerRemove  []string
+	reviewerList    bool
+	reviewerSuggest bool
+	reviewerAuto    int
 
 	// mr assignee flags
 	assigneeAdd    []string
@@ -153,11 +289,84 @@ var (
 	updateAssigneeIDs        []int
 	updateReviewerIDs        []int
 	updateMilestoneID        int
+	updateMilestone          string
 	updateAllowCollab        bool
 	updateNoAllowCollab      bool
 	updateDiscussionLocked   bool
 	updateNoDiscussionLocked bool
 	updateJSON               bool
+
+	// mr approve/approvals flags
+	approveSHA     string
+	approvePinHead bool
+	approvalsJSON  bool
+
+	// mr diff flags
+	diffStat         bool
+	diffNameOnly     bool
+	diffPaths        []string
+	diffExclude      []string
+	diffVersion      int
+	diffRange        string
+	diffListVersions bool
+	diffOutput       string
+	diffNoColor      bool
+
+	// mr checkout flags
+	checkoutBranch string
+	checkoutRemote string
+	checkoutMRRef  bool
+	checkoutForce  bool
+
+	// mr issues flags
+	issuesJSON bool
+
+	// mr stale flags
+	staleProject      int
+	staleGroup        string
+	staleMine         bool
+	staleLimit        int
+	staleDays         int
+	staleDraftDays    int
+	staleBehind       int
+	staleGrace        int
+	staleExclude      []string
+	staleApplyLabel   bool
+	staleApplyComment bool
+	staleApplyClose   bool
+	staleYes          bool
+	staleJSON         bool
+
+	// mr bulk flags
+	bulkProject         int
+	bulkGroup           string
+	bulkMine            bool
+	bulkApproved        bool
+	bulkStdin           bool
+	bulkLimit           int
+	bulkAddLabels       []string
+	bulkRemoveLabels    []string
+	bulkAddReviewers    []string
+	bulkRemoveReviewers []string
+	bulkAddAssignees    []string
+	bulkRemoveAssignees []string
+	bulkMilestone       string
+	bulkDraft           bool
+	bulkNoDraft         bool
+	bulkClose           bool
+	bulkRebase          bool
+	bulkConcurrency     int
+	bulkYes             bool
+
+	// mr stack flags
+	stackProject  string
+	stackBase     string
+	stackDraft    bool
+	stackPush     bool
+	stackForce    bool
+	stackRemote   string
+	stackNoRebase bool
+	stackJSON     bool
 )
 
 func init() {
@@ -170,7 +379,19 @@ func init() {
 	mrCmd.AddCommand(mrLabelCmd)
 	mrCmd.AddCommand(mrAutoMergeCmd)
 	mrCmd.AddCommand(mrReviewerCmd)
+	mrCmd.AddCommand(mrStaleCmd)
 	mrCmd.AddCommand(mrAssigneeCmd)
+	mrCmd.AddCommand(mrApproveCmd)
+	mrCmd.AddCommand(mrUnapproveCmd)
+	mrCmd.AddCommand(mrApprovalsCmd)
+	mrCmd.AddCommand(mrDiffCmd)
+	mrCmd.AddCommand(mrCheckoutCmd)
+	mrCmd.AddCommand(mrIssuesCmd)
+	mrCmd.AddCommand(mrBulkCmd)
+	mrCmd.AddCommand(mrStackCmd)
+	mrStackCmd.AddCommand(mrStackCreateCmd)
+	mrStackCmd.AddCommand(mrStackSyncCmd)
+	mrStackCmd.AddCommand(mrStackShowCmd)
 
 	// Persistent flag for cache bypass - inherited by all MR subcommands
 	mrCmd.PersistentFlags().BoolVar(&noCacheFlag, "no-cache", false, "bypass MR list cache")
@@ -179,6 +400,8 @@ func init() {
 	mrCmd.PersistentFlags().IntVar(&selectIndex, "select", 0, "select match by index when multiple found")
 
 	mrListCmd.Flags().IntVar(&listProject, "project", 0, "filter by project ID")
+	mrListCmd.Flags().StringVar(&listGroup, "group", "", "only MRs of this group and its subgroups (ID or path)")
+	mrListCmd.MarkFlagsMutuallyExclusive("project", "group")
 	mrListCmd.Flags().BoolVar(&listMine, "mine", false, "only MRs assigned to me")
 	mrListCmd.Flags().BoolVar(&listApproved, "approved", false, "only approved MRs")
 	mrShowCmd.Flags().BoolVar(&showJSON, "json", false, "output as JSON")
@@ -188,22 +411,27 @@ func init() {
 	mrMergeCmd.Flags().BoolVar(&mergeAutoRebase, "auto-rebase", false, "automatically rebase if needed")
 	mrMergeCmd.Flags().IntVar(&mergeMaxRetries, "max-retries", 3, "max rebase attempts")
 	mrMergeCmd.Flags().StringVar(&mergeTimeout, "timeout", "5m", "overall timeout")
+	mrMergeCmd.Flags().StringVar(&mergeWebhook, "webhook", "", "receive GitLab webhooks on this address and re-check as soon as the MR changes")
 
 	mrCreateCmd.Flags().StringVar(&createProject, "project", "", "project ID or path (required)")
 	mrCreateCmd.Flags().StringVar(&createSource, "source", "", "source branch (required)")
 	mrCreateCmd.Flags().StringVar(&createTarget, "target", "", "target branch (required)")
-	mrCreateCmd.Flags().StringVar(&createTitle, "title", "", "MR title (required)")
+	mrCreateCmd.Flags().StringVar(&createTitle, "title", "", "MR title (default: branch task and first commit)")
 	mrCreateCmd.Flags().StringVar(&createDescription, "description", "", "MR description")
+	mrCreateCmd.Flags().StringVar(&createTemplate, "template", "", "description template from .gitlab/merge_request_templates (name without .md)")
+	mrCreateCmd.Flags().BoolVar(&createEdit, "edit", false, "edit title and description in $EDITOR before creating")
 	mrCreateCmd.Flags().BoolVar(&createDraft, "draft", false, "create as draft MR")
 	mrCreateCmd.Flags().BoolVar(&createSquash, "squash", false, "enable squash on merge")
 	mrCreateCmd.Flags().BoolVar(&createRemoveSourceBranch, "remove-source-branch", false, "delete source branch after merge")
 	mrCreateCmd.Flags().BoolVar(&createAllowCollab, "allow-collaboration", false, "allow commits from upstream members")
 	mrCreateCmd.Flags().BoolVar(&createJSON, "json", false, "output as JSON")
 	mrCreateCmd.Flags().StringSliceVar(&createAssign, "assign", nil, "assign user by username or ID (repeatable)")
+	mrCreateCmd.Flags().StringVar(&createReviewersFrom, "reviewers-from", "", "request review from the next members of a configured team")
+	mrCreateCmd.Flags().StringSliceVar(&createCloses, "closes", nil, "issue to close on merge: IID or project#IID (repeatable)")
 	mrCreateCmd.MarkFlagRequired("project")
 	mrCreateCmd.MarkFlagRequired("source")
 	mrCreateCmd.MarkFlagRequired("target")
-	mrCreateCmd.MarkFlagRequired("title")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("description", "template")
 
 	mrLabelCmd.Flags().StringSliceVar(&labelAdd, "add", nil, "add label (repeatable)")
 	mrLabelCmd.Flags().StringSliceVar(&labelRemove, "remove", nil, "remove label (repeatable)")
@@ -214,11 +442,90 @@ func init() {
 	mrReviewerCmd.Flags().StringSliceVar(&reviewerAdd, "add", nil, "add reviewer by username or ID (repeatable)")
 	mrReviewerCmd.Flags().StringSliceVar(&reviewerRemove, "remove", nil, "remove reviewer by username or ID (repeatable)")
 	mrReviewerCmd.Flags().BoolVar(&reviewerList, "list", false, "list current reviewers")
+	mrReviewerCmd.Flags().BoolVar(&reviewerSuggest, "suggest", false, "rank reviewers from CODEOWNERS, review load and availability")
+	mrReviewerCmd.Flags().IntVar(&reviewerAuto, "auto", 0, "add the top N suggested reviewers")
 
 	mrAssigneeCmd.Flags().StringSliceVar(&assigneeAdd, "add", nil, "add assignee by username or ID (repeatable)")
 	mrAssigneeCmd.Flags().StringSliceVar(&assigneeRemove, "remove", nil, "remove assignee by username or ID (repeatable)")
 	mrAssigneeCmd.Flags().BoolVar(&assigneeList, "list", false, "list current assignees")
 
+	mrApproveCmd.Flags().StringVar(&approveSHA, "sha", "", "only approve if the MR head matches this commit SHA")
+	mrApproveCmd.Flags().BoolVar(&approvePinHead, "pin", false, "pin the approval to the MR head SHA seen now")
+	mrApproveCmd.MarkFlagsMutuallyExclusive("sha", "pin")
+	mrApprovalsCmd.Flags().BoolVar(&approvalsJSON, "json", false, "output as JSON")
+
+	mrDiffCmd.Flags().BoolVar(&diffStat, "stat", false, "show a diffstat instead of the diff")
+	mrDiffCmd.Flags().BoolVar(&diffNameOnly, "name-only", false, "show only names of changed files")
+	mrDiffCmd.Flags().StringSliceVar(&diffPaths, "path", nil, "only include files matching glob (repeatable, supports **)")
+	mrDiffCmd.Flags().StringSliceVar(&diffExclude, "exclude", nil, "exclude files matching glob (repeatable, supports **)")
+	mrDiffCmd.Flags().IntVar(&diffVersion, "version", 0, "show the diff of MR version N (1 = oldest)")
+	mrDiffCmd.Flags().StringVar(&diffRange, "range", "", "show changes between MR versions, e.g. 2..4 or 2.. for 2..latest")
+	mrDiffCmd.Flags().BoolVar(&diffListVersions, "list-versions", false, "list MR versions")
+	mrDiffCmd.Flags().StringVar(&diffOutput, "output", "diff", "output format: diff or patch (for git apply)")
+	mrDiffCmd.Flags().BoolVar(&diffNoColor, "no-color", false, "disable colored output")
+	mrDiffCmd.MarkFlagsMutuallyExclusive("stat", "name-only")
+	mrDiffCmd.MarkFlagsMutuallyExclusive("version", "range")
+
+	mrCheckoutCmd.Flags().StringVar(&checkoutBranch, "branch", "", "local branch name (default: checkout.branch_pattern from config)")
+	mrCheckoutCmd.Flags().StringVar(&checkoutRemote, "remote", "origin", "git remote of the MR's target project")
+	mrCheckoutCmd.Flags().BoolVar(&checkoutMRRef, "mr-ref", false, "fetch refs/merge-requests/<iid>/head instead of the source branch")
+	mrCheckoutCmd.Flags().BoolVar(&checkoutForce, "force", false, "reset an existing local branch to the MR head")
+
+	mrIssuesCmd.Flags().BoolVar(&issuesJSON, "json", false, "output as JSON")
+
+	mrStaleCmd.Flags().IntVar(&staleProject, "project", 0, "check MRs of project ID")
+	mrStaleCmd.Flags().StringVar(&staleGroup, "group", "", "check MRs of a group and its subgroups")
+	mrStaleCmd.Flags().BoolVar(&staleMine, "mine", false, "check MRs I created")
+	mrStaleCmd.Flags().IntVar(&staleLimit, "limit", 100, "maximum number of MRs checked")
+	mrStaleCmd.Flags().IntVar(&staleDays, "days", 30, "days without activity after which an MR is stale (default from config)")
+	mrStaleCmd.Flags().IntVar(&staleDraftDays, "draft-days", 0, "days without activity after which a draft is abandoned (default --days)")
+	mrStaleCmd.Flags().IntVar(&staleBehind, "behind", 0, "commits behind the target branch after which an MR is stale (0 disables)")
+	mrStaleCmd.Flags().IntVar(&staleGrace, "grace", 14, "days between marking and closing an idle MR (default from config)")
+	mrStaleCmd.Flags().StringSliceVar(&staleExclude, "exclude-label", nil, "skip MRs with this label (repeatable, added to stale.exclude_labels)")
+	mrStaleCmd.Flags().BoolVar(&staleApplyLabel, "label", false, "add the stale label to stale MRs")
+	mrStaleCmd.Flags().BoolVar(&staleApplyComment, "comment", false, "post the reminder on stale MRs")
+	mrStaleCmd.Flags().BoolVar(&staleApplyClose, "close", false, "close idle MRs once the grace period has passed")
+	mrStaleCmd.Flags().BoolVarP(&staleYes, "yes", "y", false, "skip confirmation prompt")
+	mrStaleCmd.Flags().BoolVar(&staleJSON, "json", false, "output the assessment as JSON (cannot be combined with --label, --comment or --close)")
+
+	mrBulkCmd.Flags().IntVar(&bulkProject, "project", 0, "select MRs of project ID")
+	mrBulkCmd.Flags().StringVar(&bulkGroup, "group", "", "select MRs of a group and its subgroups")
+	mrBulkCmd.Flags().BoolVar(&bulkMine, "mine", false, "select MRs assigned to me")
+	mrBulkCmd.Flags().BoolVar(&bulkApproved, "approved", false, "select only approved MRs")
+	mrBulkCmd.Flags().BoolVar(&bulkStdin, "stdin", false, "read MR identifiers from stdin instead of filtering (requires --yes or --dry-run)")
+	mrBulkCmd.Flags().IntVar(&bulkLimit, "limit", 100, "maximum number of MRs selected by filters")
+	mrBulkCmd.Flags().StringSliceVar(&bulkAddLabels, "add-label", nil, "add label (repeatable)")
+	mrBulkCmd.Flags().StringSliceVar(&bulkRemoveLabels, "remove-label", nil, "remove label (repeatable)")
+	mrBulkCmd.Flags().StringSliceVar(&bulkAddReviewers, "add-reviewer", nil, "add reviewer by username or ID (repeatable)")
+	mrBulkCmd.Flags().StringSliceVar(&bulkRemoveReviewers, "remove-reviewer", nil, "remove reviewer by username or ID (repeatable)")
+	mrBulkCmd.Flags().StringSliceVar(&bulkAddAssignees, "add-assignee", nil, "add assignee by username or ID (repeatable)")
+	mrBulkCmd.Flags().StringSliceVar(&bulkRemoveAssignees, "remove-assignee", nil, "remove assignee by username or ID (repeatable)")
+	mrBulkCmd.Flags().StringVar(&bulkMilestone, "milestone", "", "set milestone by title or ID (0 to unassign)")
+	mrBulkCmd.Flags().BoolVar(&bulkDraft, "draft", false, "mark as draft")
+	mrBulkCmd.Flags().BoolVar(&bulkNoDraft, "no-draft", false, "unmark as draft")
+	mrBulkCmd.Flags().BoolVar(&bulkClose, "close", false, "close the MRs")
+	mrBulkCmd.Flags().BoolVar(&bulkRebase, "rebase", false, "trigger a rebase (without waiting)")
+	mrBulkCmd.Flags().IntVar(&bulkConcurrency, "concurrency", 4, "number of MRs changed in parallel")
+	mrBulkCmd.Flags().BoolVarP(&bulkYes, "yes", "y", false, "skip confirmation prompt")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("draft", "no-draft")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("project", "group")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("stdin", "project")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("stdin", "group")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("stdin", "mine")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("stdin", "approved")
+	mrBulkCmd.MarkFlagsMutuallyExclusive("close", "rebase")
+
+	mrStackCreateCmd.Flags().StringVar(&stackProject, "project", "", "project ID or path (required)")
+	mrStackCreateCmd.Flags().StringVar(&stackBase, "base", "", "branch the bottom MR targets (required)")
+	mrStackCreateCmd.Flags().BoolVar(&stackDraft, "draft", false, "create the MRs as drafts")
+	mrStackCreateCmd.Flags().BoolVar(&stackPush, "push", false, "push the branches before opening the MRs")
+	mrStackCreateCmd.Flags().BoolVar(&stackForce, "force", false, "with --push, overwrite remote branches with --force-with-lease, e.g. after a rebase")
+	mrStackCreateCmd.Flags().StringVar(&stackRemote, "remote", "origin", "remote to push to with --push")
+	mrStackCreateCmd.MarkFlagRequired("project")
+	mrStackCreateCmd.MarkFlagRequired("base")
+	mrStackSyncCmd.Flags().BoolVar(&stackNoRebase, "no-rebase", false, "retarget without triggering rebases")
+	mrStackShowCmd.Flags().BoolVar(&stackJSON, "json", false, "output as JSON")
+
 	mrCmd.AddCommand(mrUpdateCmd)
 	mrUpdateCmd.Flags().StringVar(&updateTitle, "title", "", "new MR title")
 	mrUpdateCmd.Flags().StringVar(&updateDescription, "description", "", "new MR description")
@@ -234,6 +541,7 @@ func init() {
 	mrUpdateCmd.Flags().IntSliceVar(&updateAssigneeIDs, "assignee-ids", nil, "replace assignees by user IDs (comma-separated)")
 	mrUpdateCmd.Flags().IntSliceVar(&updateReviewerIDs, "reviewer-ids", nil, "replace reviewers by user IDs (comma-separated)")
 	mrUpdateCmd.Flags().IntVar(&updateMilestoneID, "milestone-id", 0, "set milestone ID (0 to unassign)")
+	mrUpdateCmd.Flags().StringVar(&updateMilestone, "milestone", "", "set milestone by title or ID (0 to unassign)")
 	mrUpdateCmd.Flags().BoolVar(&updateAllowCollab, "allow-collaboration", false, "allow upstream member commits")
 	mrUpdateCmd.Flags().BoolVar(&updateNoAllowCollab, "no-allow-collaboration", false, "disallow upstream member commits")
 	mrUpdateCmd.Flags().BoolVar(&updateDiscussionLocked, "discussion-locked", false, "lock discussion")
@@ -245,6 +553,7 @@ func init() {
 	mrUpdateCmd.MarkFlagsMutuallyExclusive("remove-source-branch", "no-remove-source-branch")
 	mrUpdateCmd.MarkFlagsMutuallyExclusive("allow-collaboration", "no-allow-collaboration")
 	mrUpdateCmd.MarkFlagsMutuallyExclusive("discussion-locked", "no-discussion-locked")
+	mrUpdateCmd.MarkFlagsMutuallyExclusive("milestone", "milestone-id")
 }
 
 func runMRList(cmd *cobra.Command, args []string) error {
@@ -257,11 +566,12 @@ func runMRList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	opts := gitlab.ListMROptions{
 		State:     "opened",
 		ProjectID: listProject,
+		GroupID:   listGroup,
 	}
 
 	if listMine {
@@ -308,10 +618,10 @@ func runMRShow(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -358,10 +668,10 @@ func runMRRebase(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -430,10 +740,10 @@ func runMRMerge(cmd *cobra.Command, args []string) error {
 		timeout = cfg.Timeout
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -448,6 +758,14 @@ func runMRMerge(cmd *cobra.Command, args []string) error {
 	}
 	prog.Header("MR !%d: %s", mr.IID, mr.Title)
 
+	// Merging polls and retries against live state, so dry-run stops here
+	if dryRun {
+		prog.Status(mr.DetailedMergeStatus)
+		fmt.Printf("Dry run: would merge !%d into %s (auto-rebase: %s, max retries: %d)\n",
+			mr.IID, mr.TargetBranch, yesNo(mergeAutoRebase), mergeMaxRetries)
+		return nil
+	}
+
 	// Set up context with signal handling
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -461,6 +779,23 @@ func runMRMerge(cmd *cobra.Command, args []string) error {
 		PollInterval: cfg.PollInterval,
 	}
 
+	if mergeWebhook != "" {
+		wake := make(chan struct{}, 1)
+		handler := newWebhookHandler(cfg.WebhookSecret, taskMatcher(cfg), func(e webhook.Event) {
+			if e.ProjectID != mr.ProjectID || e.MRIID != mr.IID {
+				return
+			}
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		})
+		if _, err := webhook.Listen(ctx, mergeWebhook, handler); err != nil {
+			return err
+		}
+		opts.Wake = wake
+	}
+
 	callback := func(status, detail string) {
 		prog.StopWait()
 		switch status {
@@ -499,40 +834,113 @@ func runMRCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
+
+	title := createTitle
+	description := createDescription
+
+	// Commits are only needed to derive a title or fill a template
+	var commits []gitlab.Commit
+	if title == "" || createTemplate != "" {
+		cmp, err := client.CompareBranches(createProject, createTarget, createSource)
+		if err != nil {
+			return err
+		}
+		commits = cmp.Commits
+		sortCommitsOldestFirst(commits)
+	}
+
+	task := taskMatcher(cfg).FromBranch(createProject, createSource)
+	if title == "" {
+		title = defaultMRTitle(task, commits)
+		if title == "" && !createEdit {
+			return fmt.Errorf("no commits on %s since %s to derive a title from; use --title", createSource, createTarget)
+		}
+	}
+
+	if createTemplate != "" {
+		tpl, err := loadMRTemplate(client, createProject, createTarget, createTemplate)
+		if err != nil {
+			return err
+		}
+		description = fillMRTemplate(tpl, mrTemplateVars{
+			SourceBranch: createSource,
+			TargetBranch: createTarget,
+			Task:         task,
+			Commits:      commits,
+		})
+	}
+
+	description, err = appendClosingReferences(description, createCloses)
+	if err != nil {
+		return err
+	}
+
+	if createEdit {
+		title, description, err = editMRText(title, description)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resolve assignees up front so that a typo fails before the MR exists
+	var assigneeIDs []int
+	for _, ref := range createAssign {
+		id, err := client.ResolveUserID(ref)
+		if err != nil {
+			return fmt.Errorf("resolving assignee '%s': %w", ref, err)
+		}
+		assigneeIDs = append(assigneeIDs, id)
+	}
+
+	var pick *teamPick
+	if createReviewersFrom != "" {
+		if pick, err = pickTeamReviewers(cfg, client, createReviewersFrom); err != nil {
+			return err
+		}
+	}
 
 	opts := gitlab.CreateMROptions{
 		SourceBranch:       createSource,
 		TargetBranch:       createTarget,
-		Title:              createTitle,
-		Description:        createDescription,
+		Title:              title,
+		Description:        description,
 		Draft:              createDraft,
 		Squash:             createSquash,
 		RemoveSourceBranch: createRemoveSourceBranch,
 		AllowCollaboration: createAllowCollab,
 	}
 
+	if dryRun && len(assigneeIDs) > 0 {
+		fmt.Printf("Dry run: would assign %s after creating the MR\n", strings.Join(createAssign, ", "))
+	}
+	if dryRun && pick != nil {
+		fmt.Printf("Dry run: would request review from %s (team %s) after creating the MR\n", strings.Join(pick.usernames, ", "), createReviewersFrom)
+	}
+
 	mr, err := client.CreateMR(createProject, opts)
 	if err != nil {
 		return err
 	}
 
 	// Set assignees if provided
-	if len(createAssign) > 0 {
-		var assigneeIDs []int
-		for _, ref := range createAssign {
-			id, err := client.ResolveUserID(ref)
-			if err != nil {
-				return fmt.Errorf("resolving assignee '%s': %w", ref, err)
-			}
-			assigneeIDs = append(assigneeIDs, id)
-		}
+	if len(assigneeIDs) > 0 {
 		mr, err = client.UpdateMRAssignees(mr.ProjectID, mr.IID, assigneeIDs)
 		if err != nil {
 			return fmt.Errorf("setting assignees: %w", err)
 		}
 	}
 
+	if pick != nil {
+		mr, err = client.UpdateMRReviewers(mr.ProjectID, mr.IID, pick.ids)
+		if err != nil {
+			return fmt.Errorf("setting reviewers: %w", err)
+		}
+		if err := pick.record(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: review rotation not saved: %v\n", err)
+		}
+	}
+
 	if createJSON {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -555,10 +963,10 @@ func runMRLabel(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -604,6 +1012,12 @@ func runMRLabel(cmd *cobra.Command, args []string) error {
 	for l := range labelSet {
 		newLabels = append(newLabels, l)
 	}
+	slices.Sort(newLabels)
+
+	if dryRun {
+		joined := strings.Join(newLabels, ",")
+		printMRPreview(mr, gitlab.UpdateMROptions{Labels: &joined})
+	}
 
 	mr, err = client.UpdateMRLabels(mr.ProjectID, mr.IID, newLabels)
 	if err != nil {
@@ -623,6 +1037,10 @@ func runMRLabel(cmd *cobra.Command, args []string) error {
 }
 
 func runMRReviewer(cmd *cobra.Command, args []string) error {
+	if reviewerAuto < 0 {
+		return fmt.Errorf("--auto must not be negative")
+	}
+
 	cfg, err := config.Load(cfgFile)
 	if err != nil {
 		return fmt.Errorf("loading config: %w", err)
@@ -632,10 +1050,10 @@ func runMRReviewer(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -646,12 +1064,30 @@ func runMRReviewer(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	var autoIDs []int
+	if reviewerSuggest || reviewerAuto > 0 {
+		picked, err := suggestMRReviewers(client, mr, reviewerAuto)
+		if err != nil {
+			return err
+		}
+		for _, c := range picked {
+			autoIDs = append(autoIDs, c.User.ID)
+		}
+		if reviewerAuto > 0 && len(autoIDs) == 0 {
+			fmt.Println("No available code owner to add")
+		}
+		// Suggesting alone changes nothing; --add and --remove still apply
+		if len(autoIDs) == 0 && len(reviewerAdd) == 0 && len(reviewerRemove) == 0 {
+			return nil
+		}
+	}
+
 	// If no add/remove flags, just list reviewers
-	if len(reviewerAdd) == 0 && len(reviewerRemove) == 0 {
+	if len(reviewerAdd) == 0 && len(reviewerRemove) == 0 && len(autoIDs) == 0 {
 		reviewerList = true
 	}
 
-	if reviewerList && len(reviewerAdd) == 0 && len(reviewerRemove) == 0 {
+	if reviewerList && len(reviewerAdd) == 0 && len(reviewerRemove) == 0 && len(autoIDs) == 0 {
 		fmt.Printf("Reviewers on !%d:\n", mr.IID)
 		if len(mr.Reviewers) == 0 {
 			fmt.Println("  (none)")
@@ -677,6 +1113,9 @@ func runMRReviewer(cmd *cobra.Command, args []string) error {
 		}
 		reviewerSet[id] = true
 	}
+	for _, id := range autoIDs {
+		reviewerSet[id] = true
+	}
 
 	// Resolve and remove reviewers
 	for _, ref := range reviewerRemove {
@@ -692,6 +1131,11 @@ func runMRReviewer(cmd *cobra.Command, args []string) error {
 	for id := range reviewerSet {
 		newReviewerIDs = append(newReviewerIDs, id)
 	}
+	slices.Sort(newReviewerIDs)
+
+	if dryRun {
+		printMRPreview(mr, gitlab.UpdateMROptions{ReviewerIDs: newReviewerIDs})
+	}
 
 	mr, err = client.UpdateMRReviewers(mr.ProjectID, mr.IID, newReviewerIDs)
 	if err != nil {
@@ -720,10 +1164,10 @@ func runMRAssignee(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -782,6 +1226,10 @@ func runMRAssignee(cmd *cobra.Command, args []string) error {
 	}
 	slices.Sort(newAssigneeIDs)
 
+	if dryRun {
+		printMRPreview(mr, gitlab.UpdateMROptions{AssigneeIDs: newAssigneeIDs})
+	}
+
 	mr, err = client.UpdateMRAssignees(mr.ProjectID, mr.IID, newAssigneeIDs)
 	if err != nil {
 		return err
@@ -803,7 +1251,7 @@ func runMRUpdate(cmd *cobra.Command, args []string) error {
 	// Fail fast if no property flags were provided
 	hasChanges := false
 	cmd.Flags().Visit(func(f *pflag.Flag) {
-		if f.Name != "no-cache" && f.Name != "select" && f.Name != "json" {
+		if f.Name != "no-cache" && f.Name != "select" && f.Name != "json" && f.Name != "dry-run" {
 			hasChanges = true
 		}
 	})
@@ -820,9 +1268,9 @@ func runMRUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -899,6 +1347,13 @@ func runMRUpdate(cmd *cobra.Command, args []string) error {
 	if cmd.Flags().Changed("milestone-id") {
 		opts.MilestoneID = &updateMilestoneID
 	}
+	if cmd.Flags().Changed("milestone") {
+		milestoneID, err := client.ResolveMilestoneID(strconv.Itoa(mr.ProjectID), updateMilestone)
+		if err != nil {
+			return fmt.Errorf("resolving milestone '%s': %w", updateMilestone, err)
+		}
+		opts.MilestoneID = &milestoneID
+	}
 	if cmd.Flags().Changed("assignee-ids") {
 		opts.AssigneeIDs = updateAssigneeIDs
 	}
@@ -906,6 +1361,10 @@ func runMRUpdate(cmd *cobra.Command, args []string) error {
 		opts.ReviewerIDs = updateReviewerIDs
 	}
 
+	if dryRun {
+		printMRPreview(mr, opts)
+	}
+
 	updated, err := client.UpdateMR(mr.ProjectID, mr.IID, opts)
 	if err != nil {
 		return err
@@ -1065,10 +1524,31 @@ func showMRActivity(client *gitlab.Client, mr *gitlab.MergeRequest, unresolvedOn
 	return nil
 }
 
+// printMRPreview prints the before/after values of the fields of mr that an
+// update with opts would change.
+func printMRPreview(mr *gitlab.MergeRequest, opts gitlab.UpdateMROptions) {
+	changes := gitlab.PreviewMRUpdate(mr, opts)
+
+	fmt.Printf("Dry run: changes to !%d\n", mr.IID)
+	if len(changes) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, c := range changes {
+		fmt.Fprintf(w, "  %s\t%s\t→ %s\n", c.Field, dashIfEmpty(truncate(c.Before, 50)), dashIfEmpty(truncate(c.After, 50)))
+	}
+	w.Flush()
+}
+
 func formatTimestamp(ts string) string {
 	// Parse ISO timestamp and format nicely
 	t, err := time.Parse(time.RFC3339, ts)
 	if err != nil {
+		if len(ts) < 16 {
+			return ts
+		}
 		return ts[:16] // Fallback: just trim
 	}
 	return t.Format("2006-01-02 15:04")
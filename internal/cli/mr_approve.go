@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func runMRApprove(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	sha := approveSHA
+	if approvePinHead {
+		sha = mr.SHA
+	}
+
+	approvals, err := client.ApproveMR(mr.ProjectID, mr.IID, sha)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Approved !%d: %s\n", mr.IID, mr.Title)
+	if sha != "" {
+		fmt.Printf("Pinned to:    %s\n", shortSHA(sha))
+	}
+	fmt.Printf("Status:       %s\n", approvalSummary(approvals))
+
+	return nil
+}
+
+func runMRUnapprove(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	if err := client.UnapproveMR(mr.ProjectID, mr.IID); err != nil {
+		return err
+	}
+
+	fmt.Printf("Approval revoked for !%d\n", mr.IID)
+	return nil
+}
+
+func runMRApprovals(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	approvals, err := client.GetMRApprovalState(mr.ProjectID, mr.IID)
+	if err != nil {
+		return err
+	}
+
+	if approvalsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(approvals)
+	}
+
+	fmt.Printf("Approvals on !%d: %s\n", mr.IID, approvalSummary(approvals))
+	fmt.Println(strings.Repeat("─", 50))
+
+	if len(approvals.Approvers) == 0 {
+		fmt.Println("Approved by:  (none)")
+	} else {
+		names := make([]string, len(approvals.Approvers))
+		for i, a := range approvals.Approvers {
+			names[i] = a.User.Username
+		}
+		fmt.Printf("Approved by:  %s\n", strings.Join(names, ", "))
+	}
+	if approvals.RulesOverwritten {
+		fmt.Println("Rules:        overridden on this MR")
+	}
+
+	var rules, codeOwnerRules []gitlab.ApprovalRule
+	for _, r := range approvals.Rules {
+		if r.RuleType == "code_owner" {
+			codeOwnerRules = append(codeOwnerRules, r)
+		} else {
+			rules = append(rules, r)
+		}
+	}
+
+	if len(rules) > 0 {
+		fmt.Println()
+		fmt.Println("── Rules ──")
+		printApprovalRules(rules)
+	}
+
+	if len(codeOwnerRules) > 0 {
+		fmt.Println()
+		fmt.Println("── Code owners ──")
+		for _, section := range groupRulesBySection(codeOwnerRules) {
+			name := section.name
+			if name == "" || name == "codeowners" {
+				name = "Default section"
+			}
+			fmt.Printf("[%s]\n", name)
+			printApprovalRules(section.rules)
+		}
+	}
+
+	return nil
+}
+
+func printApprovalRules(rules []gitlab.ApprovalRule) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tRULE\tTYPE\tAPPROVED\tLEFT\tAPPROVED BY\tELIGIBLE")
+
+	for _, r := range rules {
+		mark := "○"
+		if r.Approved {
+			mark = "✓"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%d\t%s\t%s\n",
+			mark,
+			truncate(r.Name, 30),
+			r.RuleType,
+			len(r.ApprovedBy), r.ApprovalsRequired,
+			approvalsLeft(r),
+			joinUsernames(r.ApprovedBy, "-"),
+			truncate(joinUsernames(r.EligibleApprovers, "-"), 40),
+		)
+	}
+
+	w.Flush()
+}
+
+type ruleSection struct {
+	name  string
+	rules []gitlab.ApprovalRule
+}
+
+// groupRulesBySection groups code-owner rules by their CODEOWNERS section,
+// keeping sections in the order GitLab returned them.
+func groupRulesBySection(rules []gitlab.ApprovalRule) []ruleSection {
+	var sections []ruleSection
+	index := make(map[string]int)
+
+	for _, r := range rules {
+		i, ok := index[r.Section]
+		if !ok {
+			i = len(sections)
+			index[r.Section] = i
+			sections = append(sections, ruleSection{name: r.Section})
+		}
+		sections[i].rules = append(sections[i].rules, r)
+	}
+
+	return sections
+}
+
+func approvalsLeft(r gitlab.ApprovalRule) int {
+	left := r.ApprovalsRequired - len(r.ApprovedBy)
+	if left < 0 {
+		return 0
+	}
+	return left
+}
+
+func approvalSummary(state *gitlab.ApprovalState) string {
+	if state.Approved {
+		return fmt.Sprintf("approved (%d approval(s))", len(state.Approvers))
+	}
+	return fmt.Sprintf("not approved (%d of %d, %d left)",
+		len(state.Approvers), state.ApprovalsRequired, state.ApprovalsLeft)
+}
+
+func joinUsernames(users []gitlab.User, empty string) string {
+	if len(users) == 0 {
+		return empty
+	}
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Username
+	}
+	return strings.Join(names, ", ")
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
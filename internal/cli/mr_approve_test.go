@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestApprovalsLeft(t *testing.T) {
+	tests := []struct {
+		name string
+		rule gitlab.ApprovalRule
+		want int
+	}{
+		{"none approved", gitlab.ApprovalRule{ApprovalsRequired: 2}, 2},
+		{"partially approved", gitlab.ApprovalRule{ApprovalsRequired: 2, ApprovedBy: []gitlab.User{{ID: 1}}}, 1},
+		{"over-approved clamps to zero", gitlab.ApprovalRule{ApprovalsRequired: 1, ApprovedBy: []gitlab.User{{ID: 1}, {ID: 2}}}, 0},
+		{"optional rule", gitlab.ApprovalRule{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := approvalsLeft(tt.rule); got != tt.want {
+				t.Errorf("approvalsLeft() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupRulesBySection(t *testing.T) {
+	rules := []gitlab.ApprovalRule{
+		{Name: "*.go", Section: "Backend"},
+		{Name: "*.ts", Section: "Frontend"},
+		{Name: "/db/", Section: "Backend"},
+	}
+
+	sections := groupRulesBySection(rules)
+
+	if len(sections) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(sections))
+	}
+	if sections[0].name != "Backend" || len(sections[0].rules) != 2 {
+		t.Errorf("first section = %q with %d rules, want Backend with 2", sections[0].name, len(sections[0].rules))
+	}
+	if sections[1].name != "Frontend" || len(sections[1].rules) != 1 {
+		t.Errorf("second section = %q with %d rules, want Frontend with 1", sections[1].name, len(sections[1].rules))
+	}
+}
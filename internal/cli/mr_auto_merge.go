@@ -5,7 +5,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/user/gitlab-cli/internal/config"
-	"github.com/user/gitlab-cli/internal/gitlab"
 )
 
 func runMRAutoMerge(cmd *cobra.Command, args []string) error {
@@ -18,10 +17,10 @@ func runMRAutoMerge(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	// Resolution layer: supports #NNNNN (task number), NNNNN (IID), and large numbers (global ID fallback)
-	result, err := ResolveIdentifier(client, args[0])
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,390 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+)
+
+// bulkAction is the set of changes mr bulk applies to every selected MR.
+// User references are already resolved to IDs; Milestones maps project ID
+// to milestone ID and is nil when the milestone is left alone.
+type bulkAction struct {
+	AddLabels       []string
+	RemoveLabels    []string
+	AddReviewers    []int
+	RemoveReviewers []int
+	AddAssignees    []int
+	RemoveAssignees []int
+	Milestones      map[int]int
+	Draft           *bool
+	Close           bool
+	Rebase          bool
+}
+
+type bulkResult struct {
+	MR     gitlab.MergeRequest
+	Status string
+	Err    error
+}
+
+func runMRBulk(cmd *cobra.Command, args []string) error {
+	if !bulkStdin && bulkProject == 0 && bulkGroup == "" && !bulkMine {
+		return fmt.Errorf("select MRs with --project, --group, --mine or --stdin")
+	}
+	// The confirmation prompt reads stdin too, which --stdin has consumed
+	if bulkStdin && !bulkYes && !dryRun {
+		return fmt.Errorf("--stdin needs --yes or --dry-run: the confirmation prompt cannot read stdin")
+	}
+
+	action := bulkAction{
+		AddLabels:    bulkAddLabels,
+		RemoveLabels: bulkRemoveLabels,
+		Close:        bulkClose,
+		Rebase:       bulkRebase,
+	}
+	if bulkDraft || bulkNoDraft {
+		draft := bulkDraft
+		action.Draft = &draft
+	}
+
+	summary := describeBulkAction()
+	if len(summary) == 0 {
+		return fmt.Errorf("no changes requested; see --help for the available actions")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	for _, ids := range []struct {
+		refs []string
+		dst  *[]int
+	}{
+		{bulkAddReviewers, &action.AddReviewers},
+		{bulkRemoveReviewers, &action.RemoveReviewers},
+		{bulkAddAssignees, &action.AddAssignees},
+		{bulkRemoveAssignees, &action.RemoveAssignees},
+	} {
+		for _, ref := range ids.refs {
+			id, err := client.ResolveUserID(ref)
+			if err != nil {
+				return fmt.Errorf("resolving user '%s': %w", ref, err)
+			}
+			*ids.dst = append(*ids.dst, id)
+		}
+	}
+
+	var mrs []gitlab.MergeRequest
+	if bulkStdin {
+		mrs, err = resolveBulkStdin(client, taskMatcher(cfg), os.Stdin)
+	} else {
+		opts := gitlab.ListMROptions{
+			State:     "opened",
+			ProjectID: bulkProject,
+			GroupID:   bulkGroup,
+			PerPage:   bulkLimit,
+		}
+		if bulkMine {
+			opts.Scope = "assigned_to_me"
+		}
+		if bulkApproved {
+			opts.ApprovedByIDs = "Any"
+		}
+		mrs, err = client.ListMRs(opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(mrs) == 0 {
+		fmt.Println("No merge requests selected")
+		return nil
+	}
+
+	if bulkMilestone != "" {
+		action.Milestones = make(map[int]int)
+		for _, mr := range mrs {
+			if _, ok := action.Milestones[mr.ProjectID]; ok {
+				continue
+			}
+			id, err := client.ResolveMilestoneID(strconv.Itoa(mr.ProjectID), bulkMilestone)
+			if err != nil {
+				return fmt.Errorf("project %d: %w", mr.ProjectID, err)
+			}
+			action.Milestones[mr.ProjectID] = id
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tIID\tPROJECT\tAUTHOR\tTITLE")
+	for _, mr := range mrs {
+		fmt.Fprintf(w, "%d\t!%d\t%d\t%s\t%s\n", mr.ID, mr.IID, mr.ProjectID, mr.Author.Username, truncate(mr.Title, 50))
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Println("Changes:")
+	for _, line := range summary {
+		fmt.Printf("  • %s\n", line)
+	}
+	fmt.Println()
+
+	if dryRun {
+		fmt.Printf("Dry run: would change %d merge request(s)\n", len(mrs))
+		return nil
+	}
+
+	if !bulkYes && !confirmPrompt(fmt.Sprintf("Apply to %d merge request(s)?", len(mrs))) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	results := applyBulkConcurrently(mrs, bulkConcurrency, func(mr gitlab.MergeRequest) (string, error) {
+		return applyBulkAction(client, mr, action)
+	})
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("✗ !%d (project %d): %v\n", r.MR.IID, r.MR.ProjectID, r.Err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ !%d (project %d): %s\n", r.MR.IID, r.MR.ProjectID, r.Status)
+	}
+
+	fmt.Printf("\nChanged %d of %d merge request(s)\n", len(results)-failed, len(results))
+	if failed > 0 {
+		return fmt.Errorf("%d merge request(s) failed", failed)
+	}
+	return nil
+}
+
+// describeBulkAction lists the requested changes as they were given on the
+// command line.
+func describeBulkAction() []string {
+	var lines []string
+	add := func(label string, values []string) {
+		if len(values) > 0 {
+			lines = append(lines, label+": "+strings.Join(values, ", "))
+		}
+	}
+
+	add("add labels", bulkAddLabels)
+	add("remove labels", bulkRemoveLabels)
+	add("add reviewers", bulkAddReviewers)
+	add("remove reviewers", bulkRemoveReviewers)
+	add("add assignees", bulkAddAssignees)
+	add("remove assignees", bulkRemoveAssignees)
+	if bulkMilestone == "0" {
+		lines = append(lines, "unassign milestone")
+	} else if bulkMilestone != "" {
+		lines = append(lines, "set milestone: "+bulkMilestone)
+	}
+	if bulkDraft {
+		lines = append(lines, "mark as draft")
+	}
+	if bulkNoDraft {
+		lines = append(lines, "mark as ready")
+	}
+	if bulkClose {
+		lines = append(lines, "close")
+	}
+	if bulkRebase {
+		lines = append(lines, "rebase")
+	}
+	return lines
+}
+
+// resolveBulkStdin resolves one MR identifier per input line, ignoring
+// duplicates.
+func resolveBulkStdin(client *gitlab.Client, tasks *task.Matcher, r io.Reader) ([]gitlab.MergeRequest, error) {
+	refs, err := readMRRefs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	var mrs []gitlab.MergeRequest
+	for _, ref := range refs {
+		result, err := ResolveIdentifier(client, tasks, ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", ref, err)
+		}
+		if seen[result.GlobalID] {
+			continue
+		}
+		seen[result.GlobalID] = true
+
+		mr, err := client.GetMRByGlobalID(result.GlobalID)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, *mr)
+	}
+	return mrs, nil
+}
+
+// readMRRefs returns the first field of each non-blank line, skipping an
+// "ID" header so that mr list output can be piped in.
+func readMRRefs(r io.Reader) ([]string, error) {
+	var refs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] == "ID" {
+			continue
+		}
+		refs = append(refs, fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading stdin: %w", err)
+	}
+	return refs, nil
+}
+
+// applyBulkConcurrently runs fn for every MR on up to workers goroutines and
+// returns the results in input order.
+func applyBulkConcurrently(mrs []gitlab.MergeRequest, workers int, fn func(gitlab.MergeRequest) (string, error)) []bulkResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]bulkResult, len(mrs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				status, err := fn(mrs[i])
+				results[i] = bulkResult{MR: mrs[i], Status: status, Err: err}
+			}
+		}()
+	}
+
+	for i := range mrs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func applyBulkAction(client *gitlab.Client, mr gitlab.MergeRequest, action bulkAction) (string, error) {
+	var done []string
+
+	if opts, ok := planBulkUpdate(mr, action); ok {
+		if _, err := client.UpdateMR(mr.ProjectID, mr.IID, opts); err != nil {
+			return "", err
+		}
+		done = append(done, "updated")
+	}
+
+	if action.Rebase {
+		if err := client.RebaseMR(mr.ProjectID, mr.IID); err != nil {
+			return "", err
+		}
+		done = append(done, "rebase started")
+	}
+
+	if len(done) == 0 {
+		return "already up to date", nil
+	}
+	return strings.Join(done, ", "), nil
+}
+
+// planBulkUpdate computes the update for one MR, setting only the fields
+// whose value actually changes. It reports false when nothing changes.
+func planBulkUpdate(mr gitlab.MergeRequest, action bulkAction) (gitlab.UpdateMROptions, bool) {
+	var opts gitlab.UpdateMROptions
+	changed := false
+
+	if labels := applyAddRemove(mr.Labels, action.AddLabels, action.RemoveLabels); !slices.Equal(labels, mr.Labels) {
+		joined := strings.Join(labels, ",")
+		opts.Labels = &joined
+		changed = true
+	}
+
+	if ids := applyAddRemove(userIDs(mr.Reviewers), action.AddReviewers, action.RemoveReviewers); !slices.Equal(ids, userIDs(mr.Reviewers)) {
+		opts.ReviewerIDs = ids
+		changed = true
+	}
+
+	if ids := applyAddRemove(userIDs(mr.Assignees), action.AddAssignees, action.RemoveAssignees); !slices.Equal(ids, userIDs(mr.Assignees)) {
+		opts.AssigneeIDs = ids
+		changed = true
+	}
+
+	if id, ok := action.Milestones[mr.ProjectID]; ok {
+		current := 0
+		if mr.Milestone != nil {
+			current = mr.Milestone.ID
+		}
+		if id != current {
+			opts.MilestoneID = &id
+			changed = true
+		}
+	}
+
+	if action.Draft != nil && *action.Draft != mr.Draft {
+		opts.Draft = action.Draft
+		changed = true
+	}
+
+	if action.Close && mr.State == "opened" {
+		closeEvent := "close"
+		opts.StateEvent = &closeEvent
+		changed = true
+	}
+
+	return opts, changed
+}
+
+// applyAddRemove keeps the order of current, drops removed values and
+// appends added values that are not present yet. The result is never nil so
+// that clearing a list is sent as an empty list.
+func applyAddRemove[T comparable](current, add, remove []T) []T {
+	result := make([]T, 0, len(current)+len(add))
+	for _, v := range current {
+		if !slices.Contains(remove, v) {
+			result = append(result, v)
+		}
+	}
+	for _, v := range add {
+		if !slices.Contains(result, v) && !slices.Contains(remove, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func userIDs(users []gitlab.User) []int {
+	ids := make([]int, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestPlanBulkUpdate(t *testing.T) {
+	draft := true
+	mr := gitlab.MergeRequest{
+		ProjectID: 5,
+		State:     "opened",
+		Labels:    []string{"backend", "needs-review"},
+		Reviewers: []gitlab.User{{ID: 1}, {ID: 2}},
+		Assignees: []gitlab.User{{ID: 3}},
+		Milestone: &gitlab.Milestone{ID: 7},
+	}
+
+	tests := []struct {
+		name        string
+		action      bulkAction
+		wantChanged bool
+		check       func(t *testing.T, opts gitlab.UpdateMROptions)
+	}{
+		{
+			name:        "add and remove labels",
+			action:      bulkAction{AddLabels: []string{"urgent", "backend"}, RemoveLabels: []string{"needs-review"}},
+			wantChanged: true,
+			check: func(t *testing.T, opts gitlab.UpdateMROptions) {
+				if opts.Labels == nil || *opts.Labels != "backend,urgent" {
+					t.Errorf("labels = %v, want backend,urgent", opts.Labels)
+				}
+				if opts.ReviewerIDs != nil || opts.AssigneeIDs != nil {
+					t.Error("reviewers/assignees should be untouched")
+				}
+			},
+		},
+		{
+			name:        "labels already present",
+			action:      bulkAction{AddLabels: []string{"backend"}, RemoveLabels: []string{"other"}},
+			wantChanged: false,
+		},
+		{
+			name:        "remove last assignee sends empty list",
+			action:      bulkAction{RemoveAssignees: []int{3}, AddReviewers: []int{9}},
+			wantChanged: true,
+			check: func(t *testing.T, opts gitlab.UpdateMROptions) {
+				if opts.AssigneeIDs == nil || len(opts.AssigneeIDs) != 0 {
+					t.Errorf("assignees = %v, want empty non-nil", opts.AssigneeIDs)
+				}
+				if len(opts.ReviewerIDs) != 3 || opts.ReviewerIDs[2] != 9 {
+					t.Errorf("reviewers = %v, want [1 2 9]", opts.ReviewerIDs)
+				}
+			},
+		},
+		{
+			name:        "milestone per project",
+			action:      bulkAction{Milestones: map[int]int{5: 8, 6: 7}},
+			wantChanged: true,
+			check: func(t *testing.T, opts gitlab.UpdateMROptions) {
+				if opts.MilestoneID == nil || *opts.MilestoneID != 8 {
+					t.Errorf("milestone = %v, want 8", opts.MilestoneID)
+				}
+			},
+		},
+		{
+			name:        "same milestone",
+			action:      bulkAction{Milestones: map[int]int{5: 7}},
+			wantChanged: false,
+		},
+		{
+			name:        "draft and close",
+			action:      bulkAction{Draft: &draft, Close: true},
+			wantChanged: true,
+			check: func(t *testing.T, opts gitlab.UpdateMROptions) {
+				if opts.Draft == nil || !*opts.Draft {
+					t.Error("draft should be set")
+				}
+				if opts.StateEvent == nil || *opts.StateEvent != "close" {
+					t.Errorf("state event = %v, want close", opts.StateEvent)
+				}
+			},
+		},
+		{
+			name:        "rebase only needs no update",
+			action:      bulkAction{Rebase: true},
+			wantChanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, changed := planBulkUpdate(mr, tt.action)
+			if changed != tt.wantChanged {
+				t.Fatalf("changed = %v, want %v (%+v)", changed, tt.wantChanged, opts)
+			}
+			if tt.check != nil {
+				tt.check(t, opts)
+			}
+		})
+	}
+}
+
+func TestReadMRRefs(t *testing.T) {
+	input := `ID      IID  PROJECT  TITLE     STATUS
+1001    12   5        Fix bug   mergeable
+
+#51706
+  42  
+`
+	refs, err := readMRRefs(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("readMRRefs() error = %v", err)
+	}
+
+	want := []string{"1001", "#51706", "42"}
+	if strings.Join(refs, " ") != strings.Join(want, " ") {
+		t.Errorf("refs = %v, want %v", refs, want)
+	}
+}
+
+func TestApplyBulkConcurrently(t *testing.T) {
+	mrs := make([]gitlab.MergeRequest, 20)
+	for i := range mrs {
+		mrs[i] = gitlab.MergeRequest{IID: i + 1}
+	}
+
+	var calls atomic.Int32
+	results := applyBulkConcurrently(mrs, 4, func(mr gitlab.MergeRequest) (string, error) {
+		calls.Add(1)
+		if mr.IID%5 == 0 {
+			return "", errors.New("forbidden")
+		}
+		return "updated", nil
+	})
+
+	if calls.Load() != 20 {
+		t.Errorf("fn called %d times, want 20", calls.Load())
+	}
+	for i, r := range results {
+		if r.MR.IID != i+1 {
+			t.Fatalf("result %d is for !%d, want input order", i, r.MR.IID)
+		}
+		if (r.Err != nil) != (r.MR.IID%5 == 0) {
+			t.Errorf("!%d: err = %v", r.MR.IID, r.Err)
+		}
+	}
+}
+
+func TestRunMRBulkStdin(t *testing.T) {
+	cacheDir = t.TempDir()
+	defer func() {
+		cacheDir = ""
+		bulkStdin, bulkClose, bulkYes, cfgFile = false, false, false, ""
+	}()
+
+	mr := gitlab.MergeRequest{ID: 1001, IID: 12, ProjectID: 5, State: "opened"}
+	var closed atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/merge_requests":
+			json.NewEncoder(w).Encode([]gitlab.MergeRequest{mr})
+		case r.URL.Path == "/api/v4/projects/5/merge_requests/12" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(mr)
+		case r.URL.Path == "/api/v4/projects/5/merge_requests/12" && r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			closed.Store(strings.Contains(string(body), `"state_event":"close"`))
+			json.NewEncoder(w).Encode(mr)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cfgFile = filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgFile, []byte("gitlab_url: "+srv.URL+"\ngitlab_token: token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stdin := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(stdin, []byte("12\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	bulkStdin, bulkClose = true, true
+
+	// The prompt would read the consumed stdin and always abort
+	if err := runMRBulk(mrBulkCmd, nil); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Fatalf("expected --yes to be required, got %v", err)
+	}
+
+	f, err := os.Open(stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	saved := os.Stdin
+	os.Stdin = f
+	defer func() { os.Stdin = saved }()
+
+	bulkYes = true
+	if err := runMRBulk(mrBulkCmd, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !closed.Load() {
+		t.Error("MR read from stdin was not closed")
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func runMRCheckout(cmd *cobra.Command, args []string) error {
+	// Refuse early so nothing is fetched into a repository we would not touch
+	if err := gitEnsureCleanTree(); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	localBranch := checkoutBranch
+	if localBranch == "" {
+		localBranch = expandBranchPattern(cfg.CheckoutBranchPattern, mr)
+	}
+	if err := gitRun("check-ref-format", "--branch", localBranch); err != nil {
+		return fmt.Errorf("invalid local branch name %q", localBranch)
+	}
+
+	// Fork MRs are only reachable through the target project's MR ref
+	isFork := mr.SourceProjectID != 0 && mr.SourceProjectID != mr.ProjectID
+	useMRRef := checkoutMRRef || isFork
+
+	var startPoint, upstream string
+	if useMRRef {
+		mrRef := fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)
+		fmt.Printf("Fetching %s from %s...\n", mrRef, checkoutRemote)
+		if err := gitRun("fetch", checkoutRemote, mrRef); err != nil {
+			return err
+		}
+		startPoint = "FETCH_HEAD"
+		upstream = fmt.Sprintf("%s %s", checkoutRemote, mrRef)
+	} else {
+		fmt.Printf("Fetching %s from %s...\n", mr.SourceBranch, checkoutRemote)
+		refspec := fmt.Sprintf("+refs/heads/%s:refs/remotes/%s/%s", mr.SourceBranch, checkoutRemote, mr.SourceBranch)
+		if err := gitRun("fetch", checkoutRemote, refspec); err != nil {
+			return err
+		}
+		startPoint = checkoutRemote + "/" + mr.SourceBranch
+		upstream = startPoint
+	}
+
+	// Resolve FETCH_HEAD now; later git commands may overwrite it
+	commit, err := gitOutput("rev-parse", startPoint)
+	if err != nil {
+		return err
+	}
+
+	if gitBranchExists(localBranch) && !checkoutForce {
+		if err := gitRun("checkout", localBranch); err != nil {
+			return err
+		}
+		if err := gitRun("merge", "--ff-only", commit); err != nil {
+			return fmt.Errorf("local branch %s has diverged from the MR; use --force to reset it", localBranch)
+		}
+	} else {
+		if err := gitRun("checkout", "-B", localBranch, commit); err != nil {
+			return err
+		}
+	}
+
+	if useMRRef {
+		if err := gitRun("config", "branch."+localBranch+".remote", checkoutRemote); err != nil {
+			return err
+		}
+		if err := gitRun("config", "branch."+localBranch+".merge", fmt.Sprintf("refs/merge-requests/%d/head", mr.IID)); err != nil {
+			return err
+		}
+	} else {
+		if err := gitRun("branch", "--set-upstream-to="+startPoint, localBranch); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Checked out !%d into %s (%s)\n", mr.IID, localBranch, shortSHA(commit))
+	fmt.Printf("Tracking:     %s\n", upstream)
+	if isFork {
+		fmt.Printf("Note: source branch lives in fork project %d; push there to update the MR\n", mr.SourceProjectID)
+	}
+
+	return nil
+}
+
+// expandBranchPattern fills the checkout branch pattern placeholders and
+// replaces whitespace, which git does not allow in branch names.
+func expandBranchPattern(pattern string, mr *gitlab.MergeRequest) string {
+	if pattern == "" {
+		pattern = "{source_branch}"
+	}
+
+	r := strings.NewReplacer(
+		"{iid}", strconv.Itoa(mr.IID),
+		"{source_branch}", mr.SourceBranch,
+		"{author}", mr.Author.Username,
+		"{project_id}", strconv.Itoa(mr.ProjectID),
+	)
+
+	return strings.Join(strings.Fields(r.Replace(pattern)), "-")
+}
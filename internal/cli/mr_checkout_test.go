@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestExpandBranchPattern(t *testing.T) {
+	mr := &gitlab.MergeRequest{
+		IID:          3106,
+		ProjectID:    253,
+		SourceBranch: "feature/50607-login",
+		Author:       gitlab.User{Username: "jdoe"},
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{"empty pattern defaults to source branch", "", "feature/50607-login"},
+		{"source branch", "{source_branch}", "feature/50607-login"},
+		{"iid prefix", "mr/{iid}", "mr/3106"},
+		{"combined", "review/{author}/{iid}-{project_id}", "review/jdoe/3106-253"},
+		{"whitespace replaced", "mr {iid}", "mr-3106"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expandBranchPattern(tt.pattern, mr); got != tt.want {
+				t.Errorf("expandBranchPattern(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
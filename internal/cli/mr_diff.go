@@ -0,0 +1,278 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+const (
+	colorReset = "\033[0m"
+	colorBold  = "\033[1m"
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorCyan  = "\033[36m"
+)
+
+func runMRDiff(cmd *cobra.Command, args []string) error {
+	if diffOutput != "diff" && diffOutput != "patch" {
+		return fmt.Errorf("invalid --output value %q: must be \"diff\" or \"patch\"", diffOutput)
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	// Keep stdout clean when the patch is piped into git apply
+	if diffOutput == "patch" {
+		fmt.Fprintln(os.Stderr, FormatResolutionOutput(result))
+	} else {
+		PrintResolutionInfo(result)
+	}
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	var diffs []gitlab.MRDiff
+
+	if diffListVersions || diffVersion > 0 || diffRange != "" {
+		versions, err := client.GetMRVersions(mr.ProjectID, mr.IID)
+		if err != nil {
+			return err
+		}
+		// API returns newest first; number them oldest first
+		for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+			versions[i], versions[j] = versions[j], versions[i]
+		}
+
+		switch {
+		case diffListVersions:
+			return printMRVersions(versions)
+		case diffVersion > 0:
+			if diffVersion > len(versions) {
+				return fmt.Errorf("MR !%d has only %d version(s)", mr.IID, len(versions))
+			}
+			version, err := client.GetMRVersion(mr.ProjectID, mr.IID, versions[diffVersion-1].ID)
+			if err != nil {
+				return err
+			}
+			diffs = version.Diffs
+		default:
+			from, to, err := parseVersionRange(diffRange, len(versions))
+			if err != nil {
+				return err
+			}
+			cmp, err := client.CompareRefs(mr.ProjectID, versions[from-1].HeadCommitSHA, versions[to-1].HeadCommitSHA)
+			if err != nil {
+				return err
+			}
+			if cmp.CompareTimeout {
+				fmt.Fprintln(os.Stderr, "Warning: comparison timed out, diff may be incomplete")
+			}
+			diffs = cmp.Diffs
+		}
+	} else {
+		diffs, err = client.GetMRDiffs(mr.ProjectID, mr.IID)
+		if err != nil {
+			return err
+		}
+	}
+
+	diffs = gitlab.FilterDiffs(diffs, diffPaths, diffExclude)
+
+	if len(diffs) == 0 {
+		fmt.Fprintln(os.Stderr, "No changes")
+		return nil
+	}
+
+	switch {
+	case diffNameOnly:
+		for _, d := range diffs {
+			fmt.Println(d.NewPath)
+		}
+		return nil
+	case diffStat:
+		return printDiffStat(os.Stdout, diffs)
+	}
+
+	color := diffOutput == "diff" && !diffNoColor && isTerminal(os.Stdout)
+	for _, d := range diffs {
+		if d.TooLarge || d.Collapsed {
+			fmt.Fprintf(os.Stderr, "Warning: diff for %s is too large and was omitted by GitLab\n", d.NewPath)
+			continue
+		}
+		writeFileDiff(os.Stdout, d, color)
+	}
+
+	return nil
+}
+
+func printMRVersions(versions []gitlab.MRVersion) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tHEAD\tBASE\tCREATED\tFILES")
+
+	for i, v := range versions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			i+1, shortSHA(v.HeadCommitSHA), shortSHA(v.BaseCommitSHA), formatTimestamp(v.CreatedAt), v.RealSize)
+	}
+
+	return w.Flush()
+}
+
+// parseVersionRange parses "A..B" (or "A.." meaning A..latest) into
+// 1-based version numbers bounded by count.
+func parseVersionRange(s string, count int) (int, int, error) {
+	parts := strings.SplitN(s, "..", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid --range %q: use A..B", s)
+	}
+
+	from, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid --range %q: use A..B", s)
+	}
+
+	to := count
+	if parts[1] != "" {
+		to, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid --range %q: use A..B", s)
+		}
+	}
+
+	if from < 1 || to > count || from >= to {
+		return 0, 0, fmt.Errorf("invalid --range %q: versions must satisfy 1 <= A < B <= %d", s, count)
+	}
+
+	return from, to, nil
+}
+
+func printDiffStat(out io.Writer, diffs []gitlab.MRDiff) error {
+	const barWidth = 40
+
+	type fileStat struct {
+		name       string
+		add, del   int
+		binaryLike bool
+	}
+
+	stats := make([]fileStat, 0, len(diffs))
+	maxChanges := 0
+	totalAdd, totalDel := 0, 0
+	for _, d := range diffs {
+		name := d.NewPath
+		if d.RenamedFile {
+			name = fmt.Sprintf("%s => %s", d.OldPath, d.NewPath)
+		}
+		add, del := gitlab.DiffStats(d.Diff)
+		stats = append(stats, fileStat{name: name, add: add, del: del, binaryLike: d.TooLarge || d.Collapsed})
+		if add+del > maxChanges {
+			maxChanges = add + del
+		}
+		totalAdd += add
+		totalDel += del
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 1, ' ', 0)
+	for _, s := range stats {
+		if s.binaryLike {
+			fmt.Fprintf(w, " %s\t| (too large)\n", s.name)
+			continue
+		}
+		plus, minus := s.add, s.del
+		if maxChanges > barWidth {
+			plus = s.add * barWidth / maxChanges
+			minus = s.del * barWidth / maxChanges
+		}
+		fmt.Fprintf(w, " %s\t| %d %s%s\n", s.name, s.add+s.del,
+			strings.Repeat("+", plus), strings.Repeat("-", minus))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, " %d file(s) changed, %d insertion(s)(+), %d deletion(s)(-)\n",
+		len(stats), totalAdd, totalDel)
+	return err
+}
+
+// writeFileDiff writes a single file diff in git's extended format so the
+// plain output can be fed to git apply.
+func writeFileDiff(out io.Writer, d gitlab.MRDiff, color bool) {
+	paint := func(code, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + colorReset
+	}
+
+	var header strings.Builder
+	fmt.Fprintf(&header, "diff --git a/%s b/%s\n", d.OldPath, d.NewPath)
+	switch {
+	case d.NewFile:
+		fmt.Fprintf(&header, "new file mode %s\n", d.BMode)
+	case d.DeletedFile:
+		fmt.Fprintf(&header, "deleted file mode %s\n", d.AMode)
+	case d.AMode != "" && d.BMode != "" && d.AMode != d.BMode:
+		fmt.Fprintf(&header, "old mode %s\nnew mode %s\n", d.AMode, d.BMode)
+	}
+	if d.RenamedFile {
+		fmt.Fprintf(&header, "rename from %s\nrename to %s\n", d.OldPath, d.NewPath)
+	}
+	if d.Diff != "" {
+		oldName, newName := "a/"+d.OldPath, "b/"+d.NewPath
+		if d.NewFile {
+			oldName = "/dev/null"
+		}
+		if d.DeletedFile {
+			newName = "/dev/null"
+		}
+		fmt.Fprintf(&header, "--- %s\n+++ %s\n", oldName, newName)
+	}
+	fmt.Fprint(out, paint(colorBold, strings.TrimSuffix(header.String(), "\n"))+"\n")
+
+	body := strings.TrimSuffix(d.Diff, "\n")
+	if body == "" {
+		return
+	}
+	for _, line := range strings.Split(body, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			line = paint(colorCyan, line)
+		case strings.HasPrefix(line, "+"):
+			line = paint(colorGreen, line)
+		case strings.HasPrefix(line, "-"):
+			line = paint(colorRed, line)
+		}
+		fmt.Fprintln(out, line)
+	}
+}
+
+// isTerminal reports whether f is attached to a character device.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
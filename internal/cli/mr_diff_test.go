@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestParseVersionRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		count    int
+		wantFrom int
+		wantTo   int
+		wantErr  bool
+	}{
+		{"1..3", 3, 1, 3, false},
+		{"2..", 4, 2, 4, false},
+		{"3..3", 3, 0, 0, true},
+		{"0..2", 3, 0, 0, true},
+		{"1..5", 3, 0, 0, true},
+		{"abc", 3, 0, 0, true},
+		{"a..2", 3, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			from, to, err := parseVersionRange(tt.input, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseVersionRange(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("parseVersionRange(%q) = %d..%d, want %d..%d", tt.input, from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestWriteFileDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		diff gitlab.MRDiff
+		want string
+	}{
+		{
+			name: "modified file",
+			diff: gitlab.MRDiff{OldPath: "a.go", NewPath: "a.go", AMode: "100644", BMode: "100644", Diff: "@@ -1 +1 @@\n-x\n+y\n"},
+			want: "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1 +1 @@\n-x\n+y\n",
+		},
+		{
+			name: "new file",
+			diff: gitlab.MRDiff{OldPath: "b.go", NewPath: "b.go", BMode: "100644", NewFile: true, Diff: "@@ -0,0 +1 @@\n+y\n"},
+			want: "diff --git a/b.go b/b.go\nnew file mode 100644\n--- /dev/null\n+++ b/b.go\n@@ -0,0 +1 @@\n+y\n",
+		},
+		{
+			name: "pure rename",
+			diff: gitlab.MRDiff{OldPath: "old.go", NewPath: "new.go", AMode: "100644", BMode: "100644", RenamedFile: true},
+			want: "diff --git a/old.go b/new.go\nrename from old.go\nrename to new.go\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeFileDiff(&buf, tt.diff, false)
+			if buf.String() != tt.want {
+				t.Errorf("writeFileDiff() =\n%s\nwant:\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+)
+
+func runMRIssues(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	result, err := ResolveIdentifier(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.GetMRClosesIssues(mr.ProjectID, mr.IID)
+	if err != nil {
+		return err
+	}
+
+	if issuesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issues)
+	}
+
+	if len(issues) == 0 {
+		fmt.Printf("!%d does not close any issues\n", mr.IID)
+		return nil
+	}
+
+	fmt.Printf("!%d closes %d issue(s):\n", mr.IID, len(issues))
+	printIssueTable(issues)
+	return nil
+}
+
+// appendClosingReferences appends a "Closes <ref>" line per issue so GitLab
+// closes the issues when the MR merges. Refs may be "123", "#123" or
+// "group/repo#123" for issues in another project.
+func appendClosingReferences(description string, refs []string) (string, error) {
+	if len(refs) == 0 {
+		return description, nil
+	}
+
+	lines := make([]string, 0, len(refs))
+	for _, raw := range refs {
+		ref, err := parseIssueRef(strings.TrimPrefix(strings.TrimSpace(raw), "#"))
+		if err != nil {
+			return "", fmt.Errorf("invalid --closes value %q: use IID or project#IID", raw)
+		}
+		lines = append(lines, fmt.Sprintf("Closes %s#%d", ref.Project, ref.IID))
+	}
+
+	closing := strings.Join(lines, "\n")
+	if strings.TrimSpace(description) == "" {
+		return closing, nil
+	}
+	return strings.TrimRight(description, "\n") + "\n\n" + closing, nil
+}
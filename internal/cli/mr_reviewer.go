@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/reviewers"
+)
+
+// suggestMRReviewers prints the ranked code owners of mr and returns the top
+// auto of them that can be added.
+func suggestMRReviewers(client *gitlab.Client, mr *gitlab.MergeRequest, auto int) ([]reviewers.Candidate, error) {
+	candidates, err := reviewers.Suggest(client, mr)
+	if errors.Is(err, reviewers.ErrNoCodeOwners) {
+		return nil, fmt.Errorf("cannot suggest reviewers: %w on %s (looked in %v)", err, mr.TargetBranch, reviewers.CodeOwnersPaths)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		fmt.Printf("No code owners of the changes in !%d besides the author\n", mr.IID)
+		return nil, nil
+	}
+
+	fmt.Printf("Suggested reviewers for !%d:\n", mr.IID)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RANK\tUSER\tFILES\tLOAD\tSCORE\tSTATUS")
+	for i, c := range candidates {
+		status := c.Status
+		if c.Reviewer {
+			status = strings.TrimSpace("reviewing " + status)
+		}
+		if status == "" {
+			status = "-"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%.2f\t%s\n", i+1, c.User.Username, c.Files, c.Load, c.Score, truncate(status, 40))
+	}
+	w.Flush()
+
+	return reviewers.Pick(candidates, auto), nil
+}
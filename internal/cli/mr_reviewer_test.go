@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestRunMRReviewerSuggestWithAdd(t *testing.T) {
+	cacheDir = t.TempDir()
+	defer func() {
+		cacheDir, cfgFile = "", ""
+		reviewerSuggest, reviewerAuto, reviewerAdd = false, 0, nil
+	}()
+
+	mr := gitlab.MergeRequest{ID: 1001, IID: 12, ProjectID: 5, TargetBranch: "main", Author: gitlab.User{ID: 1, Username: "alice"}}
+	var updated []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/merge_requests" && r.URL.Query().Get("reviewer_id") != "":
+			w.Write([]byte("[]"))
+		case r.URL.Path == "/api/v4/merge_requests":
+			json.NewEncoder(w).Encode([]gitlab.MergeRequest{mr})
+		case r.URL.Path == "/api/v4/projects/5/merge_requests/12" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(mr)
+		case r.URL.Path == "/api/v4/projects/5/merge_requests/12" && r.Method == http.MethodPut:
+			var body struct {
+				ReviewerIDs []int `json:"reviewer_ids"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			updated = body.ReviewerIDs
+			json.NewEncoder(w).Encode(mr)
+		case r.URL.Path == "/api/v4/projects/5/merge_requests/12/diffs":
+			json.NewEncoder(w).Encode([]gitlab.MRDiff{{OldPath: "api/x.go", NewPath: "api/x.go"}})
+		case strings.HasSuffix(r.URL.Path, "/repository/files/CODEOWNERS/raw"):
+			w.Write([]byte("* @bob\n"))
+		case r.URL.Path == "/api/v4/users":
+			json.NewEncoder(w).Encode([]gitlab.User{{ID: 2, Username: "bob"}})
+		case r.URL.Path == "/api/v4/users/2/status":
+			w.Write([]byte("{}"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cfgFile = filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(cfgFile, []byte("gitlab_url: "+srv.URL+"\ngitlab_token: token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	reviewerSuggest, reviewerAuto = true, -1
+	if err := runMRReviewer(mrReviewerCmd, []string{"12"}); err == nil {
+		t.Error("expected an error for a negative --auto")
+	}
+
+	// Suggesting must not swallow --add
+	reviewerAuto, reviewerAdd = 0, []string{"7"}
+	if err := runMRReviewer(mrReviewerCmd, []string{"12"}); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(updated, []int{7}) {
+		t.Errorf("reviewers set to %v, want [7]", updated)
+	}
+}
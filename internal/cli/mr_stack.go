@@ -0,0 +1,396 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+)
+
+const stackBlockEnd = "<!-- /gitlab-cli stack -->"
+
+var (
+	stackBlockStartRegex = regexp.MustCompile(`<!-- gitlab-cli stack base=(\S+) -->`)
+	stackBlockItemRegex  = regexp.MustCompile(`(?m)^\d+\. (?:\*\*)?!(\d+)`)
+)
+
+// stackRetarget is an open MR of a stack whose target branch has to change.
+type stackRetarget struct {
+	MR   *gitlab.MergeRequest
+	From string
+	To   string
+}
+
+func runMRStackCreate(cmd *cobra.Command, args []string) error {
+	if stackForce && !stackPush {
+		return fmt.Errorf("--force only applies with --push")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+	tasks := taskMatcher(cfg)
+
+	if stackPush && !dryRun {
+		for _, branch := range args {
+			fmt.Printf("Pushing %s...\n", branch)
+			if err := gitRun(stackPushArgs(branch)...); err != nil {
+				return err
+			}
+		}
+	}
+
+	open, err := client.ListProjectMRs(stackProject, "opened")
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]*gitlab.MergeRequest)
+	for i := range open {
+		existing[open[i].SourceBranch] = &open[i]
+	}
+
+	members := make([]gitlab.MergeRequest, 0, len(args))
+	target := stackBase
+	for _, branch := range args {
+		if mr, ok := existing[branch]; ok {
+			if mr.TargetBranch != target && dryRun {
+				fmt.Printf("Dry run: would retarget !%d (%s): %s → %s\n", mr.IID, branch, mr.TargetBranch, target)
+			} else if mr.TargetBranch != target {
+				fmt.Printf("Retargeting !%d (%s): %s → %s\n", mr.IID, branch, mr.TargetBranch, target)
+				mr, err = client.UpdateMR(mr.ProjectID, mr.IID, gitlab.UpdateMROptions{TargetBranch: &target})
+				if err != nil {
+					return err
+				}
+			} else {
+				fmt.Printf("Using existing !%d (%s → %s)\n", mr.IID, branch, target)
+			}
+			members = append(members, *mr)
+			target = branch
+			continue
+		}
+
+		title, err := stackMRTitle(client, tasks, target, branch)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			fmt.Printf("Dry run: would create MR %s → %s: %s\n", branch, target, title)
+			target = branch
+			continue
+		}
+
+		mr, err := client.CreateMR(stackProject, gitlab.CreateMROptions{
+			SourceBranch: branch,
+			TargetBranch: target,
+			Title:        title,
+			Draft:        stackDraft,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created !%d (%s → %s): %s\n", mr.IID, branch, target, mr.Title)
+
+		members = append(members, *mr)
+		target = branch
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	if err := updateStackBlocks(client, stackBase, members); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	printStack(stackBase, members, 0)
+	return nil
+}
+
+// stackPushArgs returns the git arguments that push branch to the stack's
+// remote, overwriting it only with --force.
+func stackPushArgs(branch string) []string {
+	args := []string{"push"}
+	if stackForce {
+		args = append(args, "--force-with-lease")
+	}
+	return append(args, "--set-upstream", stackRemote, branch)
+}
+
+// stackMRTitle derives an MR title from the branch's task and first commit
+// on top of target, falling back to the branch name.
+func stackMRTitle(client *gitlab.Client, tasks *task.Matcher, target, branch string) (string, error) {
+	cmp, err := client.CompareBranches(stackProject, target, branch)
+	if err != nil {
+		return "", err
+	}
+	sortCommitsOldestFirst(cmp.Commits)
+
+	if title := defaultMRTitle(tasks.FromBranch(stackProject, branch), cmp.Commits); title != "" {
+		return title, nil
+	}
+	return branch, nil
+}
+
+func runMRStackSync(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	base, members, _, err := loadStack(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+
+	retargets := planStackSync(base, members)
+	if len(retargets) == 0 {
+		fmt.Println("All open MRs already target the right branch")
+	}
+
+	if dryRun {
+		for _, r := range retargets {
+			fmt.Printf("Dry run: would retarget !%d (%s): %s → %s", r.MR.IID, r.MR.SourceBranch, r.From, r.To)
+			if !stackNoRebase {
+				fmt.Print(" and rebase it")
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	for _, r := range retargets {
+		fmt.Printf("Retargeting !%d (%s): %s → %s\n", r.MR.IID, r.MR.SourceBranch, r.From, r.To)
+		to := r.To
+		updated, err := client.UpdateMR(r.MR.ProjectID, r.MR.IID, gitlab.UpdateMROptions{TargetBranch: &to})
+		if err != nil {
+			return err
+		}
+		*r.MR = *updated
+
+		if stackNoRebase {
+			continue
+		}
+		if err := client.RebaseMR(r.MR.ProjectID, r.MR.IID); err != nil {
+			return fmt.Errorf("rebasing !%d: %w", r.MR.IID, err)
+		}
+		fmt.Printf("  rebase triggered for !%d\n", r.MR.IID)
+	}
+
+	if err := updateStackBlocks(client, base, members); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	printStack(base, members, 0)
+	return nil
+}
+
+func runMRStackShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	base, members, current, err := loadStack(client, taskMatcher(cfg), args[0])
+	if err != nil {
+		return err
+	}
+
+	if stackJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Base          string                `json:"base"`
+			MergeRequests []gitlab.MergeRequest `json:"merge_requests"`
+			NeedsSync     bool                  `json:"needs_sync"`
+		}{base, members, len(planStackSync(base, members)) > 0})
+	}
+
+	printStack(base, members, current)
+	if len(planStackSync(base, members)) > 0 {
+		fmt.Println()
+		fmt.Printf("Targets are out of date; run: gitlab-cli mr stack sync %s\n", args[0])
+	}
+	return nil
+}
+
+// loadStack resolves an MR identifier and loads every MR of the stack the MR
+// belongs to, bottom first. current is the IID of the resolved MR.
+func loadStack(client *gitlab.Client, tasks *task.Matcher, ref string) (string, []gitlab.MergeRequest, int, error) {
+	result, err := ResolveIdentifier(client, tasks, ref)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	PrintResolutionInfo(result)
+
+	mr, err := client.GetMRByGlobalID(result.GlobalID)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	base, iids, ok := parseStackBlock(mr.Description)
+	if !ok {
+		return "", nil, 0, fmt.Errorf("!%d is not part of a stack (no stack block in its description)", mr.IID)
+	}
+
+	members := make([]gitlab.MergeRequest, 0, len(iids))
+	for _, iid := range iids {
+		if iid == mr.IID {
+			members = append(members, *mr)
+			continue
+		}
+		member, err := client.GetMR(mr.ProjectID, iid)
+		if err != nil {
+			return "", nil, 0, err
+		}
+		members = append(members, *member)
+	}
+
+	return base, members, mr.IID, nil
+}
+
+// planStackSync computes the target branch each open MR should have: the
+// source branch of the nearest open MR below it, or base. Merged and closed
+// MRs drop out of the chain.
+func planStackSync(base string, members []gitlab.MergeRequest) []stackRetarget {
+	var retargets []stackRetarget
+	target := base
+	for i := range members {
+		mr := &members[i]
+		if mr.State != "opened" {
+			continue
+		}
+		if mr.TargetBranch != target {
+			retargets = append(retargets, stackRetarget{MR: mr, From: mr.TargetBranch, To: target})
+		}
+		target = mr.SourceBranch
+	}
+	return retargets
+}
+
+// updateStackBlocks writes the navigation block into the description of every
+// open member whose block is missing or out of date.
+func updateStackBlocks(client *gitlab.Client, base string, members []gitlab.MergeRequest) error {
+	for i := range members {
+		mr := &members[i]
+		if mr.State != "opened" {
+			continue
+		}
+
+		description := replaceStackBlock(mr.Description, renderStackBlock(base, members, mr.IID))
+		if description == mr.Description {
+			continue
+		}
+
+		updated, err := client.UpdateMR(mr.ProjectID, mr.IID, gitlab.UpdateMROptions{Description: &description})
+		if err != nil {
+			return fmt.Errorf("updating stack links of !%d: %w", mr.IID, err)
+		}
+		*mr = *updated
+	}
+	return nil
+}
+
+// renderStackBlock renders the navigation block for the MR with IID current.
+func renderStackBlock(base string, members []gitlab.MergeRequest, current int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!-- gitlab-cli stack base=%s -->\n", base)
+	fmt.Fprintf(&b, "**Stack** (bottom to top, based on `%s`):\n\n", base)
+	for i, mr := range members {
+		item := fmt.Sprintf("!%d %s", mr.IID, mr.Title)
+		if mr.IID == current {
+			item = "**" + item + "** ← this MR"
+		}
+		if mr.State != "opened" {
+			item += " — " + mr.State
+		}
+		fmt.Fprintf(&b, "%d. %s\n", i+1, item)
+	}
+	b.WriteString(stackBlockEnd)
+	return b.String()
+}
+
+// parseStackBlock extracts the base branch and the member IIDs, bottom first,
+// from a description containing a stack block.
+func parseStackBlock(description string) (string, []int, bool) {
+	loc := stackBlockStartRegex.FindStringSubmatchIndex(description)
+	if loc == nil {
+		return "", nil, false
+	}
+	base := description[loc[2]:loc[3]]
+
+	body := description[loc[1]:]
+	if end := strings.Index(body, stackBlockEnd); end >= 0 {
+		body = body[:end]
+	}
+
+	var iids []int
+	for _, m := range stackBlockItemRegex.FindAllStringSubmatch(body, -1) {
+		iid, _ := strconv.Atoi(m[1])
+		iids = append(iids, iid)
+	}
+	return base, iids, len(iids) > 0
+}
+
+// replaceStackBlock swaps the stack block of description for block, or
+// appends block when there is none.
+func replaceStackBlock(description, block string) string {
+	loc := stackBlockStartRegex.FindStringIndex(description)
+	if loc == nil {
+		if strings.TrimSpace(description) == "" {
+			return block
+		}
+		return strings.TrimRight(description, "\n") + "\n\n" + block
+	}
+
+	end := len(description)
+	if i := strings.Index(description[loc[0]:], stackBlockEnd); i >= 0 {
+		end = loc[0] + i + len(stackBlockEnd)
+	}
+	return description[:loc[0]] + block + description[end:]
+}
+
+func printStack(base string, members []gitlab.MergeRequest, current int) {
+	fmt.Printf("Stack based on %s\n", base)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tIID\tSTATE\tBRANCH\tTARGET\tTITLE")
+	for i, mr := range members {
+		marker := ""
+		if mr.IID == current {
+			marker = " ←"
+		}
+		fmt.Fprintf(w, "%d\t!%d\t%s\t%s\t%s\t%s%s\n",
+			i+1, mr.IID, mr.State, mr.SourceBranch, mr.TargetBranch, truncate(mr.Title, 40), marker)
+	}
+	w.Flush()
+}
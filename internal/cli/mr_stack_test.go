@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func testStack() []gitlab.MergeRequest {
+	return []gitlab.MergeRequest{
+		{IID: 101, Title: "Add model", State: "merged", SourceBranch: "feat-1", TargetBranch: "main"},
+		{IID: 102, Title: "Add API", State: "opened", SourceBranch: "feat-2", TargetBranch: "feat-1"},
+		{IID: 103, Title: "Add UI", State: "opened", SourceBranch: "feat-3", TargetBranch: "feat-2"},
+	}
+}
+
+func TestStackBlockRoundTrip(t *testing.T) {
+	block := renderStackBlock("main", testStack(), 102)
+
+	if !strings.Contains(block, "2. **!102 Add API** ← this MR") {
+		t.Errorf("current MR not highlighted:\n%s", block)
+	}
+	if !strings.Contains(block, "1. !101 Add model — merged") {
+		t.Errorf("merged MR not marked:\n%s", block)
+	}
+
+	base, iids, ok := parseStackBlock("Intro text\n\n" + block + "\n\nFooter")
+	if !ok || base != "main" || fmt.Sprint(iids) != "[101 102 103]" {
+		t.Errorf("parseStackBlock() = %q, %v, %v", base, iids, ok)
+	}
+
+	if _, _, ok := parseStackBlock("1. !5 not a stack"); ok {
+		t.Error("expected no stack without a start marker")
+	}
+}
+
+func TestReplaceStackBlock(t *testing.T) {
+	block := renderStackBlock("main", testStack(), 103)
+
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{"empty description", "", block},
+		{"appends after text", "Some text\n", "Some text\n\n" + block},
+		{
+			"replaces existing block in place",
+			"Before\n\n" + renderStackBlock("main", testStack()[:2], 102) + "\n\nAfter",
+			"Before\n\n" + block + "\n\nAfter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replaceStackBlock(tt.description, block); got != tt.want {
+				t.Errorf("replaceStackBlock() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	// Replacing is idempotent
+	once := replaceStackBlock("Text", block)
+	if twice := replaceStackBlock(once, block); twice != once {
+		t.Errorf("second replace changed the description:\n%s", twice)
+	}
+}
+
+func TestPlanStackSync(t *testing.T) {
+	members := testStack()
+	retargets := planStackSync("main", members)
+
+	if len(retargets) != 1 {
+		t.Fatalf("got %d retargets, want 1: %+v", len(retargets), retargets)
+	}
+	r := retargets[0]
+	if r.MR.IID != 102 || r.From != "feat-1" || r.To != "main" {
+		t.Errorf("retarget = !%d %s → %s, want !102 feat-1 → main", r.MR.IID, r.From, r.To)
+	}
+
+	// Closing the middle MR makes the top one target the bottom one
+	members = testStack()
+	members[0].State = "opened"
+	members[1].State = "closed"
+	retargets = planStackSync("main", members)
+	if len(retargets) != 1 || retargets[0].MR.IID != 103 || retargets[0].To != "feat-1" {
+		t.Errorf("unexpected retargets: %+v", retargets)
+	}
+
+	// An up-to-date stack needs nothing
+	members = testStack()
+	members[0].State = "opened"
+	if retargets := planStackSync("main", members); len(retargets) != 0 {
+		t.Errorf("expected no retargets, got %+v", retargets)
+	}
+}
+
+func TestStackPushArgs(t *testing.T) {
+	stackRemote = "origin"
+	defer func() { stackRemote, stackForce = "", false }()
+
+	if got := strings.Join(stackPushArgs("feat-1"), " "); got != "push --set-upstream origin feat-1" {
+		t.Errorf("plain push: %s", got)
+	}
+	stackForce = true
+	if got := strings.Join(stackPushArgs("feat-1"), " "); got != "push --force-with-lease --set-upstream origin feat-1" {
+		t.Errorf("forced push: %s", got)
+	}
+}
@@ -0,0 +1,397 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+// staleMarker identifies the reminders posted by mr stale.
+const staleMarker = "<!-- gitlab-cli stale -->"
+
+// defaultStaleComment is the reminder used when stale.comment is not set.
+const defaultStaleComment = `@%{author} this merge request looks stale: %{reasons}.
+
+Please update it, or close it if it is no longer needed. Without further activity it may be closed in %{grace_days} days.`
+
+// Planned stale actions.
+const (
+	staleLabel   = "label"
+	staleUnlabel = "unlabel"
+	staleComment = "comment"
+	staleClose   = "close"
+)
+
+// stalePolicy is the stale configuration with command-line overrides.
+type stalePolicy struct {
+	Days      int
+	DraftDays int
+	Behind    int
+	GraceDays int
+	Label     string
+	Comment   string
+	Exclude   []string
+}
+
+// staleMR is the assessment of one open MR.
+type staleMR struct {
+	MR           gitlab.MergeRequest `json:"-"`
+	IID          int                 `json:"iid"`
+	ProjectID    int                 `json:"project_id"`
+	Title        string              `json:"title"`
+	Author       string              `json:"author"`
+	WebURL       string              `json:"web_url"`
+	LastActivity time.Time           `json:"last_activity"`
+	IdleDays     int                 `json:"idle_days"`
+	Behind       int                 `json:"behind"` // -1 when not checked
+	Labeled      bool                `json:"labeled"`
+	MarkedAt     time.Time           `json:"marked_at,omitzero"`   // stale label added or reminder posted
+	RemindedAt   time.Time           `json:"reminded_at,omitzero"` // last reminder
+	Reasons      []string            `json:"reasons"`
+	Actions      []string            `json:"actions"`
+
+	// idle is set when the MR is stale for lack of activity rather than
+	// only for being behind its target
+	idle bool
+}
+
+func runMRStale(cmd *cobra.Command, args []string) error {
+	if staleProject == 0 && staleGroup == "" && !staleMine {
+		return fmt.Errorf("select MRs with --project, --group or --mine")
+	}
+	if staleJSON && (staleApplyLabel || staleApplyComment || staleApplyClose) {
+		return fmt.Errorf("--json only reports; drop it to apply --label, --comment or --close")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	policy := stalePolicyFrom(cfg, cmd)
+	if policy.Days <= 0 || policy.DraftDays <= 0 {
+		return fmt.Errorf("--days and --draft-days must be positive")
+	}
+	if staleApplyLabel && policy.Label == "" {
+		return fmt.Errorf("stale.label must be set to label stale MRs")
+	}
+
+	client := newClient(cfg)
+
+	opts := gitlab.ListMROptions{
+		State:     "opened",
+		ProjectID: staleProject,
+		GroupID:   staleGroup,
+		PerPage:   staleLimit,
+	}
+	if staleMine {
+		opts.Scope = "created_by_me"
+	}
+	mrs, err := client.ListMRs(opts)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var found []staleMR
+	for _, mr := range mrs {
+		if slices.ContainsFunc(mr.Labels, func(l string) bool { return containsFold(policy.Exclude, l) }) {
+			continue
+		}
+		s, err := fetchStaleMR(client, mr, policy, now)
+		if err != nil {
+			return fmt.Errorf("!%d (project %d): %w", mr.IID, mr.ProjectID, err)
+		}
+		s.Actions = planStale(s, policy, now, staleApplyLabel, staleApplyComment, staleApplyClose)
+		if len(s.Reasons) > 0 || len(s.Actions) > 0 {
+			found = append(found, *s)
+		}
+	}
+
+	if staleJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(found); err != nil {
+			return err
+		}
+	} else {
+		printStaleMRs(found)
+	}
+
+	var planned []gitlab.MergeRequest
+	for _, s := range found {
+		if len(s.Actions) > 0 {
+			planned = append(planned, s.MR)
+		}
+	}
+	if len(planned) == 0 || staleJSON {
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("\nDry run: would change %d merge request(s)\n", len(planned))
+		return nil
+	}
+
+	if !staleYes && !confirmPrompt(fmt.Sprintf("\nApply to %d merge request(s)?", len(planned))) {
+		fmt.Println("Aborted")
+		return nil
+	}
+
+	byID := make(map[int]staleMR, len(found))
+	for _, s := range found {
+		byID[s.MR.ID] = s
+	}
+	results := applyBulkConcurrently(planned, 4, func(mr gitlab.MergeRequest) (string, error) {
+		return applyStaleActions(client, byID[mr.ID], policy)
+	})
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf("✗ !%d (project %d): %v\n", r.MR.IID, r.MR.ProjectID, r.Err)
+			failed++
+			continue
+		}
+		fmt.Printf("✓ !%d (project %d): %s\n", r.MR.IID, r.MR.ProjectID, r.Status)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d merge request(s) failed", failed)
+	}
+	return nil
+}
+
+// stalePolicyFrom returns the configured policy with the flags that were
+// given on the command line applied.
+func stalePolicyFrom(cfg *config.Config, cmd *cobra.Command) stalePolicy {
+	p := stalePolicy{
+		Days:      cfg.StaleDays,
+		DraftDays: cfg.StaleDraftDays,
+		Behind:    cfg.StaleBehind,
+		GraceDays: cfg.StaleGraceDays,
+		Label:     cfg.StaleLabel,
+		Comment:   cfg.StaleComment,
+		Exclude:   append(slices.Clone(cfg.StaleExcludeLabels), staleExclude...),
+	}
+	if cmd.Flags().Changed("days") {
+		p.Days = staleDays
+	}
+	if cmd.Flags().Changed("draft-days") {
+		p.DraftDays = staleDraftDays
+	}
+	if cmd.Flags().Changed("behind") {
+		p.Behind = staleBehind
+	}
+	if cmd.Flags().Changed("grace") {
+		p.GraceDays = staleGrace
+	}
+	if p.DraftDays == 0 {
+		p.DraftDays = p.Days
+	}
+	if p.Comment == "" {
+		p.Comment = defaultStaleComment
+	}
+	return p
+}
+
+// fetchStaleMR loads the notes, pipelines, label events and divergence the
+// assessment of mr needs.
+func fetchStaleMR(client *gitlab.Client, mr gitlab.MergeRequest, p stalePolicy, now time.Time) (*staleMR, error) {
+	discussions, err := client.GetMRDiscussions(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	pipelines, err := client.GetMRPipelines(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	var labelEvents []gitlab.LabelEvent
+	if containsFold(mr.Labels, p.Label) {
+		if labelEvents, err = client.GetMRLabelEvents(mr.ProjectID, mr.IID); err != nil {
+			return nil, err
+		}
+	}
+
+	behind := -1
+	if p.Behind > 0 {
+		if behind, err = client.GetMRDivergedCommits(mr.ProjectID, mr.IID); err != nil {
+			return nil, err
+		}
+	}
+
+	return assessStale(mr, discussions, pipelines, labelEvents, behind, p, now), nil
+}
+
+// assessStale dates the last activity of mr and lists why it is stale.
+// Activity is the MR's creation, notes and pipelines, and its updated_at
+// as long as it has not been marked: labelling it or posting a reminder
+// updates it too, so the reminders and label notes themselves never count.
+func assessStale(mr gitlab.MergeRequest, discussions []gitlab.Discussion, pipelines []gitlab.PipelineInfo, labelEvents []gitlab.LabelEvent, behind int, p stalePolicy, now time.Time) *staleMR {
+	s := &staleMR{
+		MR:        mr,
+		IID:       mr.IID,
+		ProjectID: mr.ProjectID,
+		Title:     mr.Title,
+		Author:    mr.Author.Username,
+		WebURL:    mr.WebURL,
+		Behind:    behind,
+		Labeled:   containsFold(mr.Labels, p.Label),
+		Reasons:   []string{},
+	}
+
+	latest := func(t *time.Time, value string) {
+		if v, err := time.Parse(time.RFC3339, value); err == nil && v.After(*t) {
+			*t = v
+		}
+	}
+
+	for _, e := range labelEvents {
+		if e.Action == "add" && strings.EqualFold(e.Label.Name, p.Label) {
+			latest(&s.MarkedAt, e.CreatedAt)
+		}
+	}
+
+	latest(&s.LastActivity, mr.CreatedAt)
+	for _, d := range discussions {
+		for _, n := range d.Notes {
+			switch {
+			case strings.Contains(n.Body, staleMarker):
+				latest(&s.RemindedAt, n.CreatedAt)
+			case n.System && isLabelNote(n.Body):
+			default:
+				latest(&s.LastActivity, n.CreatedAt)
+			}
+		}
+	}
+	for _, pl := range pipelines {
+		latest(&s.LastActivity, pl.CreatedAt)
+	}
+	if s.RemindedAt.After(s.MarkedAt) {
+		s.MarkedAt = s.RemindedAt
+	}
+	if s.MarkedAt.IsZero() {
+		latest(&s.LastActivity, mr.UpdatedAt)
+	}
+
+	s.IdleDays = int(now.Sub(s.LastActivity).Hours() / 24)
+	switch {
+	case mr.Draft && s.IdleDays >= p.DraftDays:
+		s.idle = true
+		s.Reasons = append(s.Reasons, fmt.Sprintf("draft abandoned for %d days", s.IdleDays))
+	case !mr.Draft && s.IdleDays >= p.Days:
+		s.idle = true
+		s.Reasons = append(s.Reasons, fmt.Sprintf("no activity for %d days", s.IdleDays))
+	}
+	if p.Behind > 0 && behind >= p.Behind {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("%d commits behind %s", behind, mr.TargetBranch))
+	}
+
+	return s
+}
+
+// isLabelNote reports whether a system note records a label change, which
+// older GitLab versions post as notes.
+func isLabelNote(body string) bool {
+	return strings.HasSuffix(body, " label") || strings.HasSuffix(body, " labels")
+}
+
+// planStale decides what to do with s under the enabled policies. Idle MRs
+// marked at least the grace period ago without activity since are closed;
+// other stale MRs are labelled and reminded once per idle spell. The label
+// is removed again from MRs that are no longer stale.
+func planStale(s *staleMR, p stalePolicy, now time.Time, label, comment, closeMR bool) []string {
+	actions := []string{}
+	if len(s.Reasons) == 0 {
+		if label && s.Labeled {
+			actions = append(actions, staleUnlabel)
+		}
+		return actions
+	}
+
+	marked := !s.MarkedAt.IsZero() && !s.LastActivity.After(s.MarkedAt)
+	if closeMR && s.idle && marked && now.Sub(s.MarkedAt) >= time.Duration(p.GraceDays)*24*time.Hour {
+		return append(actions, staleClose)
+	}
+	if label && !s.Labeled {
+		actions = append(actions, staleLabel)
+	}
+	if comment && !s.RemindedAt.After(s.LastActivity) {
+		actions = append(actions, staleComment)
+	}
+	return actions
+}
+
+// staleReminder fills the reminder template for s.
+func staleReminder(s staleMR, p stalePolicy) string {
+	body := strings.NewReplacer(
+		"%{author}", s.Author,
+		"%{days}", strconv.Itoa(s.IdleDays),
+		"%{reasons}", strings.Join(s.Reasons, ", "),
+		"%{label}", p.Label,
+		"%{grace_days}", strconv.Itoa(p.GraceDays),
+		"%{target_branch}", s.MR.TargetBranch,
+	).Replace(p.Comment)
+	return strings.TrimRight(body, "\n") + "\n\n" + staleMarker
+}
+
+func applyStaleActions(client *gitlab.Client, s staleMR, p stalePolicy) (string, error) {
+	mr := s.MR
+	var done []string
+	for _, action := range s.Actions {
+		var err error
+		switch action {
+		case staleLabel:
+			_, err = client.UpdateMRLabels(mr.ProjectID, mr.IID, append(slices.Clone(mr.Labels), p.Label))
+		case staleUnlabel:
+			labels := slices.DeleteFunc(slices.Clone(mr.Labels), func(l string) bool { return strings.EqualFold(l, p.Label) })
+			_, err = client.UpdateMRLabels(mr.ProjectID, mr.IID, labels)
+		case staleComment:
+			_, err = client.CreateMRNote(mr.ProjectID, mr.IID, staleReminder(s, p))
+		case staleClose:
+			closeEvent := "close"
+			_, err = client.UpdateMR(mr.ProjectID, mr.IID, gitlab.UpdateMROptions{StateEvent: &closeEvent})
+		}
+		if err != nil {
+			return strings.Join(done, ", "), err
+		}
+		done = append(done, action)
+	}
+	return strings.Join(done, ", "), nil
+}
+
+func printStaleMRs(found []staleMR) {
+	if len(found) == 0 {
+		fmt.Println("No stale merge requests")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IID\tPROJECT\tAUTHOR\tIDLE\tREASONS\tACTIONS\tTITLE")
+	for _, s := range found {
+		actions := strings.Join(s.Actions, ", ")
+		if actions == "" {
+			actions = "-"
+		}
+		fmt.Fprintf(w, "!%d\t%d\t%s\t%dd\t%s\t%s\t%s\n",
+			s.IID, s.ProjectID, s.Author, s.IdleDays, strings.Join(s.Reasons, "; "), actions, truncate(s.Title, 40))
+	}
+	w.Flush()
+}
+
+func containsFold(list []string, s string) bool {
+	return slices.ContainsFunc(list, func(v string) bool { return strings.EqualFold(v, s) })
+}
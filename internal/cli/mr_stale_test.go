@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+var stalePolicyTest = stalePolicy{
+	Days:      30,
+	DraftDays: 14,
+	Behind:    50,
+	GraceDays: 7,
+	Label:     "stale",
+	Comment:   defaultStaleComment,
+}
+
+func staleTestMR(draft bool, labels ...string) gitlab.MergeRequest {
+	return gitlab.MergeRequest{
+		ID:           100,
+		IID:          1,
+		ProjectID:    10,
+		Title:        "Add feature",
+		Author:       gitlab.User{Username: "alice"},
+		TargetBranch: "main",
+		Draft:        draft,
+		Labels:       labels,
+		CreatedAt:    "2024-01-01T10:00:00Z",
+		UpdatedAt:    "2024-03-01T10:00:00Z",
+	}
+}
+
+func TestAssessStale(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	discussions := []gitlab.Discussion{{Notes: []gitlab.Note{
+		{Body: "LGTM", CreatedAt: "2024-04-01T10:00:00Z"},
+	}}}
+	pipelines := []gitlab.PipelineInfo{{CreatedAt: "2024-04-20T10:00:00Z"}}
+
+	s := assessStale(staleTestMR(false), discussions, pipelines, nil, 60, stalePolicyTest, now)
+	if s.IdleDays != 42 || !s.idle {
+		t.Errorf("idle days = %d (idle %v), want 42", s.IdleDays, s.idle)
+	}
+	want := []string{"no activity for 42 days", "60 commits behind main"}
+	if !slices.Equal(s.Reasons, want) {
+		t.Errorf("reasons = %v, want %v", s.Reasons, want)
+	}
+
+	// A recent draft is not abandoned; being behind alone is not idle
+	s = assessStale(staleTestMR(true), discussions, []gitlab.PipelineInfo{{CreatedAt: "2024-05-25T10:00:00Z"}}, nil, 60, stalePolicyTest, now)
+	if s.idle || !slices.Equal(s.Reasons, []string{"60 commits behind main"}) {
+		t.Errorf("unexpected assessment %+v", s)
+	}
+
+	// Reminders, label notes and updated_at after marking are no activity
+	discussions = append(discussions, gitlab.Discussion{Notes: []gitlab.Note{
+		{Body: "added ~stale label", System: true, CreatedAt: "2024-05-01T10:00:00Z"},
+		{Body: "@alice ping\n\n" + staleMarker, CreatedAt: "2024-05-01T10:00:00Z"},
+	}})
+	mr := staleTestMR(false, "stale")
+	mr.UpdatedAt = "2024-05-01T10:00:00Z"
+	events := []gitlab.LabelEvent{{Action: "add", CreatedAt: "2024-04-30T10:00:00Z", Label: gitlab.Label{Name: "Stale"}}}
+	s = assessStale(mr, discussions, pipelines, events, -1, stalePolicyTest, now)
+	if !s.Labeled || s.LastActivity.Format(time.DateOnly) != "2024-04-20" {
+		t.Errorf("unexpected assessment %+v", s)
+	}
+	if s.RemindedAt.Format(time.DateOnly) != "2024-05-01" || !s.MarkedAt.Equal(s.RemindedAt) {
+		t.Errorf("marked at %v, reminded at %v", s.MarkedAt, s.RemindedAt)
+	}
+}
+
+func TestPlanStale(t *testing.T) {
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	idle := func(lastActivity, marked, reminded time.Duration, labeled bool) *staleMR {
+		s := &staleMR{idle: true, Labeled: labeled, Reasons: []string{"no activity for 40 days"}}
+		s.LastActivity = now.Add(-lastActivity)
+		if marked > 0 {
+			s.MarkedAt = now.Add(-marked)
+		}
+		if reminded > 0 {
+			s.RemindedAt = now.Add(-reminded)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		s    *staleMR
+		want []string
+	}{
+		{"new stale MR", idle(40*day, 0, 0, false), []string{staleLabel, staleComment}},
+		{"labelled and reminded", idle(40*day, 3*day, 3*day, true), []string{}},
+		{"grace period over", idle(40*day, 8*day, 8*day, true), []string{staleClose}},
+		{"activity after reminder", idle(31*day, 35*day, 35*day, true), []string{staleComment}},
+		{"no longer stale", &staleMR{Labeled: true, Reasons: []string{}}, []string{staleUnlabel}},
+	}
+	for _, tt := range tests {
+		got := planStale(tt.s, stalePolicyTest, now, true, true, true)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: actions = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Only the enabled policies are planned
+	if got := planStale(idle(40*day, 0, 0, false), stalePolicyTest, now, false, true, false); !slices.Equal(got, []string{staleComment}) {
+		t.Errorf("comment only: actions = %v", got)
+	}
+	if got := planStale(idle(40*day, 8*day, 8*day, true), stalePolicyTest, now, true, true, false); len(got) != 0 {
+		t.Errorf("without --close: actions = %v", got)
+	}
+}
+
+func TestStaleReminder(t *testing.T) {
+	s := staleMR{
+		MR:       staleTestMR(false),
+		Author:   "alice",
+		IdleDays: 42,
+		Reasons:  []string{"no activity for 42 days", "60 commits behind main"},
+	}
+	p := stalePolicyTest
+	p.Comment = "@%{author}: %{reasons} (%{days}d, ~%{label}, %{target_branch}, %{grace_days}d)\n"
+
+	got := staleReminder(s, p)
+	want := "@alice: no activity for 42 days, 60 commits behind main (42d, ~stale, main, 7d)\n\n" + staleMarker
+	if got != want {
+		t.Errorf("reminder = %q, want %q", got, want)
+	}
+	if !strings.Contains(staleReminder(s, stalePolicyTest), "closed in 7 days") {
+		t.Error("default reminder should mention the grace period")
+	}
+}
+
+func TestRunMRStaleJSONWithActions(t *testing.T) {
+	defer func() { staleProject, staleJSON, staleApplyClose, staleYes = 0, false, false, false }()
+
+	// Rejected before any request is made
+	staleProject, staleJSON, staleApplyClose, staleYes = 42, true, true, true
+	if err := runMRStale(mrStaleCmd, nil); err == nil || !strings.Contains(err.Error(), "--json") {
+		t.Errorf("expected --json to be rejected with --close, got %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+// mrTemplateDir is where GitLab looks for merge request description
+// templates, relative to the repository root.
+const mrTemplateDir = ".gitlab/merge_request_templates"
+
+// mrTemplateVars are the values substituted into a description template.
+type mrTemplateVars struct {
+	SourceBranch string
+	TargetBranch string
+	Task         string
+	Commits      []gitlab.Commit // oldest first
+}
+
+// fillMRTemplate replaces the %{...} placeholders of a template. The names
+// follow GitLab's own template variables where one exists:
+//
+//	%{source_branch}  source branch name
+//	%{target_branch}  target branch name
+//	%{task}           task number from the branch name, e.g. #12345
+//	%{first_commit}   title of the first commit
+//	%{all_commits}    one "- title (sha)" line per commit
+//
+// Unknown placeholders are left alone.
+func fillMRTemplate(tpl string, vars mrTemplateVars) string {
+	first := ""
+	if len(vars.Commits) > 0 {
+		first = vars.Commits[0].Title
+	}
+
+	lines := make([]string, len(vars.Commits))
+	for i, c := range vars.Commits {
+		lines[i] = fmt.Sprintf("- %s (%s)", c.Title, shortSHA(c.ID))
+	}
+
+	return strings.NewReplacer(
+		"%{source_branch}", vars.SourceBranch,
+		"%{target_branch}", vars.TargetBranch,
+		"%{task}", vars.Task,
+		"%{first_commit}", first,
+		"%{all_commits}", strings.Join(lines, "\n"),
+	).Replace(tpl)
+}
+
+// defaultMRTitle derives a title from the branch's task and its first
+// commit, e.g. "#12345 Fix login redirect". The task is not repeated when
+// the commit title already mentions it.
+func defaultMRTitle(task string, commits []gitlab.Commit) string {
+	if len(commits) == 0 {
+		return ""
+	}
+
+	title := commits[0].Title
+	if task == "" || strings.Contains(title, task) {
+		return title
+	}
+	return task + " " + title
+}
+
+// sortCommitsOldestFirst orders commits by commit date. Commits whose date
+// cannot be parsed keep their relative position.
+func sortCommitsOldestFirst(commits []gitlab.Commit) {
+	sort.SliceStable(commits, func(i, j int) bool {
+		ti, erri := time.Parse(time.RFC3339, commits[i].CommittedDate)
+		tj, errj := time.Parse(time.RFC3339, commits[j].CommittedDate)
+		if erri != nil || errj != nil {
+			return false
+		}
+		return ti.Before(tj)
+	})
+}
+
+// loadMRTemplate returns the named template. When the current directory is
+// a checkout of project, its .gitlab/merge_request_templates is preferred so
+// that unpushed template changes can be tried; otherwise the template is
+// read from the project at ref through the API.
+func loadMRTemplate(client *gitlab.Client, project, ref, name string) (string, error) {
+	file := name
+	if !strings.HasSuffix(file, ".md") {
+		file += ".md"
+	}
+
+	if root, ok := localCheckoutOf(client, project); ok {
+		content, err := os.ReadFile(filepath.Join(root, mrTemplateDir, file))
+		if err == nil {
+			return string(content), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	content, err := client.GetRawFile(project, mrTemplateDir+"/"+file, ref)
+	if err != nil {
+		names, listErr := listMRTemplates(client, project, ref)
+		if listErr != nil || len(names) == 0 {
+			return "", fmt.Errorf("template '%s' not found: %w", name, err)
+		}
+		return "", fmt.Errorf("template '%s' not found; available: %s", name, strings.Join(names, ", "))
+	}
+
+	return string(content), nil
+}
+
+// localCheckoutOf returns the root of the git work tree in the current
+// directory when one of its remotes points at project (an ID or path).
+func localCheckoutOf(client *gitlab.Client, project string) (string, bool) {
+	root, err := gitOutput("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", false
+	}
+	remotes, err := gitOutput("config", "--get-regexp", `^remote\..*\.url$`)
+	if err != nil {
+		return "", false
+	}
+
+	path := project
+	if _, err := strconv.Atoi(project); err == nil {
+		p, err := client.GetProjectByIDOrPath(project)
+		if err != nil {
+			return "", false
+		}
+		path = p.PathWithNamespace
+	}
+
+	for _, line := range strings.Split(remotes, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && strings.EqualFold(remoteProjectPath(fields[1]), path) {
+			return root, true
+		}
+	}
+	return "", false
+}
+
+// remoteProjectPath returns the project path of a git remote URL, e.g.
+// "group/repo" for git@gitlab.com:group/repo.git or
+// https://gitlab.com/group/repo.git.
+func remoteProjectPath(remoteURL string) string {
+	p := remoteURL
+	if u, err := url.Parse(remoteURL); err == nil && u.Scheme != "" && u.Host != "" {
+		p = u.Path
+	} else if _, after, ok := strings.Cut(remoteURL, ":"); ok {
+		// scp-like syntax: [user@]host:path
+		p = after
+	}
+	return strings.TrimSuffix(strings.Trim(p, "/"), ".git")
+}
+
+// listMRTemplates returns the template names (without .md) of a project.
+func listMRTemplates(client *gitlab.Client, project, ref string) ([]string, error) {
+	entries, err := client.ListRepositoryTree(project, mrTemplateDir, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.Type == "blob" && strings.HasSuffix(e.Name, ".md") {
+			names = append(names, strings.TrimSuffix(e.Name, ".md"))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// editMRText opens $EDITOR (vi when unset) on the title and description and
+// returns the edited values.
+func editMRText(title, description string) (string, string, error) {
+	f, err := os.CreateTemp("", "gitlab-cli-mr-*.md")
+	if err != nil {
+		return "", "", fmt.Errorf("creating temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(formatEditableMR(title, description)); err != nil {
+		f.Close()
+		return "", "", fmt.Errorf("writing temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", "", fmt.Errorf("writing temp file: %w", err)
+	}
+
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	cmd := exec.Command(editor[0], append(editor[1:], f.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("running editor: %w", err)
+	}
+
+	content, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", "", fmt.Errorf("reading edited file: %w", err)
+	}
+
+	title, description = parseEditableMR(string(content))
+	if title == "" {
+		return "", "", fmt.Errorf("empty title, aborting")
+	}
+	return title, description, nil
+}
+
+// formatEditableMR lays out an MR like a commit message: the title on the
+// first line, then a blank line and the description.
+func formatEditableMR(title, description string) string {
+	return title + "\n\n" + description
+}
+
+// parseEditableMR is the inverse of formatEditableMR. Leading blank lines are
+// skipped and surrounding whitespace of the description is trimmed.
+func parseEditableMR(text string) (string, string) {
+	text = strings.TrimLeft(text, " \t\r\n")
+	title, description, _ := strings.Cut(text, "\n")
+	return strings.TrimSpace(title), strings.TrimSpace(description)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 func TestFormatResolutionOutput(t *testing.T) {
@@ -175,9 +176,9 @@ func TestResolutionOutputFlow(t *testing.T) {
 	taskNum := 51706
 
 	// When: Resolution succeeds
-	result, err := ResolveTaskNumber(taskNum, rawInput, mrs)
+	result, err := ResolveTaskNumber(task.DefaultMatcher(), taskNum, rawInput, mrs)
 	if err != nil {
-		t.Fatalf("ResolveTaskNumber() error = %v", err)
+		t.Fatalf("ResolveTaskNumber(task.DefaultMatcher(), ) error = %v", err)
 	}
 
 	// Then: Output format matches AC1 exactly
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -22,23 +25,57 @@ var projectListCmd = &cobra.Command{
 	RunE:  runProjectList,
 }
 
+var projectShowCmd = &cobra.Command{
+	Use:   "show <id|path>",
+	Short: "Show project details, merge settings and protected branches",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectShow,
+}
+
+var projectSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Inspect project settings",
+}
+
+var projectSettingsDiffCmd = &cobra.Command{
+	Use:   "diff <project-a> <project-b>",
+	Short: "Compare the merge-related settings of two projects",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runProjectSettingsDiff,
+}
+
 var (
 	projectSearch     string
 	projectOwned      bool
 	projectMembership bool
 	projectLimit      int
 	projectJSON       bool
+	projectGroup      string
+	projectSubgroups  bool
+
+	// project settings diff flags
+	projectDiffAll bool
 )
 
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
+	projectCmd.AddCommand(projectShowCmd)
+	projectCmd.AddCommand(projectSettingsCmd)
+	projectSettingsCmd.AddCommand(projectSettingsDiffCmd)
 
 	projectListCmd.Flags().StringVar(&projectSearch, "search", "", "filter by project name")
 	projectListCmd.Flags().BoolVar(&projectOwned, "owned", false, "only projects owned by me")
 	projectListCmd.Flags().BoolVar(&projectMembership, "membership", true, "only projects I'm a member of")
 	projectListCmd.Flags().IntVar(&projectLimit, "limit", 20, "number of results")
 	projectListCmd.Flags().BoolVar(&projectJSON, "json", false, "output as JSON")
+	projectListCmd.Flags().StringVar(&projectGroup, "group", "", "only projects of this group (ID or path)")
+	projectListCmd.Flags().BoolVar(&projectSubgroups, "include-subgroups", false, "with --group, include projects of subgroups")
+
+	projectShowCmd.Flags().BoolVar(&projectJSON, "json", false, "output as JSON")
+
+	projectSettingsDiffCmd.Flags().BoolVar(&projectDiffAll, "all", false, "show settings that are equal too")
+	projectSettingsDiffCmd.Flags().BoolVar(&projectJSON, "json", false, "output as JSON")
 }
 
 func runProjectList(cmd *cobra.Command, args []string) error {
@@ -51,13 +88,19 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	if projectSubgroups && projectGroup == "" {
+		return fmt.Errorf("--include-subgroups requires --group")
+	}
+
+	client := newClient(cfg)
 
 	opts := gitlab.ListProjectsOptions{
-		Search:     projectSearch,
-		Owned:      projectOwned,
-		Membership: projectMembership,
-		PerPage:    projectLimit,
+		Search:           projectSearch,
+		Owned:            projectOwned,
+		Membership:       projectMembership,
+		PerPage:          projectLimit,
+		GroupID:          projectGroup,
+		IncludeSubgroups: projectSubgroups,
 	}
 
 	projects, err := client.ListProjects(opts)
@@ -87,3 +130,297 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 	w.Flush()
 	return nil
 }
+
+// projectDetails is everything project show and settings diff need about a
+// project. Approvals and ApprovalRules are nil when the GitLab edition does
+// not provide them.
+type projectDetails struct {
+	*gitlab.Project
+	Approvals         *gitlab.ProjectApprovals `json:"approvals,omitempty"`
+	ApprovalRules     []gitlab.ApprovalRule    `json:"approval_rules,omitempty"`
+	ProtectedBranches []gitlab.ProtectedBranch `json:"protected_branches"`
+	OpenMRs           int                      `json:"open_mrs"`
+}
+
+func fetchProjectDetails(client *gitlab.Client, ref string, withMRs bool) (*projectDetails, error) {
+	project, err := client.GetProjectByIDOrPath(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &projectDetails{Project: project}
+	id := strconv.Itoa(project.ID)
+
+	// Approval settings are a Premium feature; treat failures as "not available"
+	if approvals, err := client.GetProjectApprovals(id); err == nil {
+		details.Approvals = approvals
+		if rules, err := client.ListProjectApprovalRules(id); err == nil {
+			details.ApprovalRules = rules
+		}
+	}
+
+	details.ProtectedBranches, err = client.ListProtectedBranches(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if withMRs {
+		mrs, err := client.ListProjectMRs(id, "opened")
+		if err != nil {
+			return nil, err
+		}
+		details.OpenMRs = len(mrs)
+	}
+
+	return details, nil
+}
+
+func runProjectShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	details, err := fetchProjectDetails(client, args[0], true)
+	if err != nil {
+		return err
+	}
+
+	if projectJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(details)
+	}
+
+	p := details.Project
+	fmt.Printf("Project: %s\n", p.PathWithNamespace)
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("ID:             %d\n", p.ID)
+	fmt.Printf("Name:           %s\n", p.Name)
+	fmt.Printf("Visibility:     %s\n", p.Visibility)
+	fmt.Printf("URL:            %s\n", p.WebURL)
+	if p.Description != "" {
+		fmt.Printf("Description:    %s\n", truncate(p.Description, 70))
+	}
+	if p.Archived {
+		fmt.Println("Archived:       yes")
+	}
+	fmt.Printf("Last activity:  %s\n", formatTimestamp(p.LastActivityAt))
+	fmt.Printf("Open MRs:       %d\n", details.OpenMRs)
+	fmt.Printf("Open issues:    %d\n", p.OpenIssuesCount)
+
+	fmt.Println()
+	fmt.Println("── Merge settings ──")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, s := range projectSettings(details) {
+		if strings.HasPrefix(s.Name, protectedSettingPrefix) {
+			continue
+		}
+		fmt.Fprintf(w, "%s:\t%s\n", s.Name, s.Value)
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Println("── Protected branches ──")
+	if len(details.ProtectedBranches) == 0 {
+		fmt.Println("none")
+		return nil
+	}
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPUSH\tMERGE\tFORCE PUSH")
+	for _, pb := range details.ProtectedBranches {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			pb.Name, formatAccessLevels(pb.PushAccessLevels), formatAccessLevels(pb.MergeAccessLevels), yesNo(pb.AllowForcePush))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runProjectSettingsDiff(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	a, err := fetchProjectDetails(client, args[0], false)
+	if err != nil {
+		return err
+	}
+	b, err := fetchProjectDetails(client, args[1], false)
+	if err != nil {
+		return err
+	}
+
+	rows := diffSettings(projectSettings(a), projectSettings(b))
+
+	if projectJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+
+	differ := 0
+	for _, r := range rows {
+		if r.Differs {
+			differ++
+		}
+	}
+
+	if differ == 0 && !projectDiffAll {
+		fmt.Printf("%s and %s have the same merge settings\n", a.PathWithNamespace, b.PathWithNamespace)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, " \tSETTING\t%s\t%s\n", a.PathWithNamespace, b.PathWithNamespace)
+	for _, r := range rows {
+		if !r.Differs && !projectDiffAll {
+			continue
+		}
+		marker := " "
+		if r.Differs {
+			marker = "≠"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", marker, r.Name, r.A, r.B)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d setting(s) differ\n", differ)
+	return nil
+}
+
+// protectedSettingPrefix marks per-branch rows of projectSettings, which
+// project show prints as a table of their own.
+const protectedSettingPrefix = "Protected branch "
+
+type projectSetting struct {
+	Name  string
+	Value string
+}
+
+// projectSettings flattens the merge-related settings of a project into
+// comparable name/value pairs. Approval rules and protected branches get one
+// row each so that a diff lines them up by name.
+func projectSettings(d *projectDetails) []projectSetting {
+	settings := []projectSetting{
+		{"Default branch", dashIfEmpty(d.DefaultBranch)},
+		{"Merge method", describeMergeMethod(d.MergeMethod)},
+		{"Squash commits", strings.ReplaceAll(dashIfEmpty(d.SquashOption), "_", " ")},
+		{"Pipeline must succeed", yesNo(d.OnlyAllowMergeIfPipelineSucceeds)},
+		{"Skipped pipelines succeed", yesNo(d.AllowMergeOnSkippedPipeline)},
+		{"Discussions must be resolved", yesNo(d.OnlyAllowMergeIfAllDiscussionsAreResolved)},
+		{"Delete source branch", yesNo(d.RemoveSourceBranchAfterMerge)},
+	}
+
+	if d.Approvals == nil {
+		settings = append(settings, projectSetting{"Approvals", "not available"})
+	} else {
+		a := d.Approvals
+		settings = append(settings,
+			projectSetting{"Approvals required", strconv.Itoa(a.ApprovalsBeforeMerge)},
+			projectSetting{"Reset approvals on push", yesNo(a.ResetApprovalsOnPush)},
+			projectSetting{"Author can approve", yesNo(a.MergeRequestsAuthorApproval)},
+			projectSetting{"Committers can approve", yesNo(!a.MergeRequestsDisableCommittersApproval)},
+			projectSetting{"Override approvers per MR", yesNo(!a.DisableOverridingApproversPerMergeRequest)},
+		)
+
+		rules := append([]gitlab.ApprovalRule(nil), d.ApprovalRules...)
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+		for _, r := range rules {
+			settings = append(settings, projectSetting{
+				"Approval rule " + r.Name,
+				fmt.Sprintf("%d approval(s)", r.ApprovalsRequired),
+			})
+		}
+	}
+
+	branches := append([]gitlab.ProtectedBranch(nil), d.ProtectedBranches...)
+	sort.Slice(branches, func(i, j int) bool { return branches[i].Name < branches[j].Name })
+	for _, pb := range branches {
+		value := fmt.Sprintf("push: %s, merge: %s", formatAccessLevels(pb.PushAccessLevels), formatAccessLevels(pb.MergeAccessLevels))
+		if pb.AllowForcePush {
+			value += ", force push"
+		}
+		settings = append(settings, projectSetting{protectedSettingPrefix + pb.Name, value})
+	}
+
+	return settings
+}
+
+type settingDiff struct {
+	Name    string `json:"setting"`
+	A       string `json:"a"`
+	B       string `json:"b"`
+	Differs bool   `json:"differs"`
+}
+
+// diffSettings lines up two setting lists by name, keeping the order of a
+// and appending settings only b has. Missing settings show as "-".
+func diffSettings(a, b []projectSetting) []settingDiff {
+	bValues := make(map[string]string, len(b))
+	for _, s := range b {
+		bValues[s.Name] = s.Value
+	}
+
+	seen := make(map[string]bool, len(a))
+	rows := make([]settingDiff, 0, len(a))
+	for _, s := range a {
+		seen[s.Name] = true
+		other, ok := bValues[s.Name]
+		if !ok {
+			other = "-"
+		}
+		rows = append(rows, settingDiff{Name: s.Name, A: s.Value, B: other, Differs: s.Value != other})
+	}
+
+	for _, s := range b {
+		if !seen[s.Name] {
+			rows = append(rows, settingDiff{Name: s.Name, A: "-", B: s.Value, Differs: true})
+		}
+	}
+
+	return rows
+}
+
+func describeMergeMethod(method string) string {
+	switch method {
+	case "merge":
+		return "merge commit"
+	case "rebase_merge":
+		return "merge commit with semi-linear history"
+	case "ff":
+		return "fast-forward"
+	}
+	return dashIfEmpty(method)
+}
+
+func formatAccessLevels(levels []gitlab.AccessLevel) string {
+	if len(levels) == 0 {
+		return "-"
+	}
+	names := make([]string, len(levels))
+	for i, l := range levels {
+		names[i] = l.AccessLevelDescription
+	}
+	return strings.Join(names, " + ")
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
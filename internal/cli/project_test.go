@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestProjectSettings(t *testing.T) {
+	details := &projectDetails{
+		Project: &gitlab.Project{
+			DefaultBranch:                    "main",
+			MergeMethod:                      "ff",
+			SquashOption:                     "default_on",
+			OnlyAllowMergeIfPipelineSucceeds: true,
+		},
+		Approvals: &gitlab.ProjectApprovals{ApprovalsBeforeMerge: 2},
+		ApprovalRules: []gitlab.ApprovalRule{
+			{Name: "Security", ApprovalsRequired: 1},
+			{Name: "Backend", ApprovalsRequired: 2},
+		},
+		ProtectedBranches: []gitlab.ProtectedBranch{
+			{
+				Name:              "main",
+				PushAccessLevels:  []gitlab.AccessLevel{{AccessLevelDescription: "No one"}},
+				MergeAccessLevels: []gitlab.AccessLevel{{AccessLevelDescription: "Maintainers"}},
+			},
+		},
+	}
+
+	got := make(map[string]string)
+	var order []string
+	for _, s := range projectSettings(details) {
+		got[s.Name] = s.Value
+		order = append(order, s.Name)
+	}
+
+	want := map[string]string{
+		"Merge method":            "fast-forward",
+		"Squash commits":          "default on",
+		"Pipeline must succeed":   "yes",
+		"Approvals required":      "2",
+		"Committers can approve":  "yes",
+		"Approval rule Backend":   "2 approval(s)",
+		"Protected branch main":   "push: No one, merge: Maintainers",
+		"Delete source branch":    "no",
+		"Default branch":          "main",
+		"Reset approvals on push": "no",
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("%s = %q, want %q", name, got[name], value)
+		}
+	}
+
+	// Rules are sorted by name so diffs line up
+	var rules []string
+	for _, name := range order {
+		if strings.HasPrefix(name, "Approval rule ") {
+			rules = append(rules, name)
+		}
+	}
+	if len(rules) != 2 || rules[0] != "Approval rule Backend" {
+		t.Errorf("approval rules = %v, want Backend first", rules)
+	}
+}
+
+func TestProjectSettingsWithoutApprovals(t *testing.T) {
+	settings := projectSettings(&projectDetails{Project: &gitlab.Project{}})
+
+	for _, s := range settings {
+		if s.Name == "Approvals required" {
+			t.Fatal("unexpected approvals setting without approval data")
+		}
+		if s.Name == "Approvals" && s.Value != "not available" {
+			t.Errorf("Approvals = %q, want 'not available'", s.Value)
+		}
+	}
+}
+
+func TestDiffSettings(t *testing.T) {
+	a := []projectSetting{
+		{"Merge method", "fast-forward"},
+		{"Pipeline must succeed", "yes"},
+		{"Protected branch main", "push: No one"},
+	}
+	b := []projectSetting{
+		{"Merge method", "merge commit"},
+		{"Pipeline must succeed", "yes"},
+		{"Protected branch release/*", "push: Maintainers"},
+	}
+
+	rows := diffSettings(a, b)
+
+	want := []settingDiff{
+		{Name: "Merge method", A: "fast-forward", B: "merge commit", Differs: true},
+		{Name: "Pipeline must succeed", A: "yes", B: "yes"},
+		{Name: "Protected branch main", A: "push: No one", B: "-", Differs: true},
+		{Name: "Protected branch release/*", A: "-", B: "push: Maintainers", Differs: true},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(rows), len(want), rows)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+}
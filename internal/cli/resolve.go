@@ -2,10 +2,11 @@ package cli
 
 import (
 	"fmt"
-	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 // GetMRListWithCache returns the MR list, using cache if available and not disabled.
@@ -57,16 +58,14 @@ func (e *MultiMatchError) Error() string {
 	return sb.String()
 }
 
-// containsTaskNumber checks if the title contains the task number with exact boundaries.
-// The pattern #NNNNN must be followed by a word boundary (non-alphanumeric or end of string).
-func containsTaskNumber(title string, taskNum int) bool {
+// containsTaskNumber checks if the title references the task number using the
+// task patterns of project. The number must be followed by a word boundary
+// (non-alphanumeric or end of string).
+func containsTaskNumber(tasks *task.Matcher, project, title string, taskNum int) bool {
 	if title == "" {
 		return false
 	}
-	// Pattern: #NNNNN followed by word boundary (non-alphanumeric or end of string)
-	pattern := fmt.Sprintf(`#%d(?:[^a-zA-Z0-9]|$)`, taskNum)
-	re := regexp.MustCompile(pattern)
-	return re.MatchString(title)
+	return tasks.Mentions(project, title, strconv.Itoa(taskNum))
 }
 
 // ResolveTaskNumber resolves a task number to a single MR from the provided list.
@@ -74,15 +73,36 @@ func containsTaskNumber(title string, taskNum int) bool {
 //   - ResolutionResult if exactly one MR matches
 //   - error "No MR found matching..." if no MRs match
 //   - MultiMatchError if multiple MRs match
-func ResolveTaskNumber(taskNum int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+func ResolveTaskNumber(tasks *task.Matcher, taskNum int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
 	var matches []gitlab.MergeRequest
 
 	for _, mr := range mrs {
-		if containsTaskNumber(mr.Title, taskNum) {
+		if containsTaskNumber(tasks, mrProjectPath(&mr), mr.Title, taskNum) {
 			matches = append(matches, mr)
 		}
 	}
 
+	return resolveTaskMatches(rawInput, matches)
+}
+
+// ResolveTaskRef resolves a task reference such as ABC-123 to the single MR
+// whose source branch or title yields it.
+func ResolveTaskRef(tasks *task.Matcher, ref, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+	var matches []gitlab.MergeRequest
+
+	for _, mr := range mrs {
+		project := mrProjectPath(&mr)
+		if strings.EqualFold(tasks.FromBranch(project, mr.SourceBranch), ref) || strings.EqualFold(tasks.FromText(project, mr.Title), ref) {
+			matches = append(matches, mr)
+		}
+	}
+
+	return resolveTaskMatches(rawInput, matches)
+}
+
+// resolveTaskMatches returns the single MR matching a task, or the error for
+// none or several.
+func resolveTaskMatches(rawInput string, matches []gitlab.MergeRequest) (*ResolutionResult, error) {
 	switch len(matches) {
 	case 0:
 		return nil, fmt.Errorf("No MR found matching %s in your assigned MRs", rawInput)
@@ -151,7 +171,7 @@ func NeedsGlobalIDFallback(value int) bool {
 // 1. IID match (exact IID column match)
 // 2. Task# fallback (search for #VALUE in MR titles)
 // Story 1.8: This is the core unified resolution function.
-func ResolveUnified(value int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+func ResolveUnified(tasks *task.Matcher, value int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
 	// Priority 1: Try IID match
 	result, err := ResolveIIDWithSelect(value, rawInput, mrs)
 	if err == nil {
@@ -164,7 +184,7 @@ func ResolveUnified(value int, rawInput string, mrs []gitlab.MergeRequest) (*Res
 	}
 
 	// Priority 2: Try Task# match (search for #VALUE in titles)
-	result, err = ResolveTaskNumberWithSelect(value, rawInput, mrs)
+	result, err = ResolveTaskNumberWithSelect(tasks, value, rawInput, mrs)
 	if err == nil {
 		result.MatchType = "task#" // Mark for output formatting
 		return result, nil
@@ -179,21 +199,23 @@ func ResolveUnified(value int, rawInput string, mrs []gitlab.MergeRequest) (*Res
 // Story 1.8: Uses unified resolution - IID-first, task# fallback.
 // For large numbers that don't match, caller should use the client to attempt
 // global ID fallback using NeedsGlobalIDFallback() to check.
-func ResolveIdentifierWithMRs(rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
-	parsed, err := ParseIdentifier(rawInput)
+func ResolveIdentifierWithMRs(tasks *task.Matcher, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+	parsed, err := parseMRIdentifier(tasks, rawInput)
 	if err != nil {
 		return nil, err
 	}
 
-	// Story 1.8: All identifiers are now numeric - use unified resolution
-	return ResolveUnified(parsed.Value, parsed.RawInput, mrs)
+	if parsed.Type == IdentifierTypeTask {
+		return ResolveTaskRefWithSelect(tasks, parsed.Task, parsed.RawInput, mrs)
+	}
+	return ResolveUnified(tasks, parsed.Value, parsed.RawInput, mrs)
 }
 
 // ResolveIdentifier resolves a user-provided identifier to a ResolutionResult.
 // Story 1.8: Uses unified resolution - IID-first, task# fallback.
 // Falls back to global ID if resolution fails for large numbers.
-func ResolveIdentifier(client *gitlab.Client, rawInput string) (*ResolutionResult, error) {
-	parsed, err := ParseIdentifier(rawInput)
+func ResolveIdentifier(client *gitlab.Client, tasks *task.Matcher, rawInput string) (*ResolutionResult, error) {
+	parsed, err := parseMRIdentifier(tasks, rawInput)
 	if err != nil {
 		return nil, err
 	}
@@ -203,8 +225,10 @@ func ResolveIdentifier(client *gitlab.Client, rawInput string) (*ResolutionResul
 		return nil, fmt.Errorf("loading MR list: %w", err)
 	}
 
-	// Story 1.8: All identifiers are now numeric - use unified resolution
-	result, err := ResolveUnified(parsed.Value, parsed.RawInput, mrs)
+	if parsed.Type == IdentifierTypeTask {
+		return ResolveTaskRefWithSelect(tasks, parsed.Task, parsed.RawInput, mrs)
+	}
+	result, err := ResolveUnified(tasks, parsed.Value, parsed.RawInput, mrs)
 	if err != nil && NeedsGlobalIDFallback(parsed.Value) {
 		// Fallback: large number might be a global ID
 		return resolveGlobalIDFallback(client, parsed.Value, parsed.RawInput)
@@ -5,7 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/user/gitlab-cli/internal/config"
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 func TestContainsTaskNumber(t *testing.T) {
@@ -29,8 +31,8 @@ func TestContainsTaskNumber(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.title, func(t *testing.T) {
-			if got := containsTaskNumber(tt.title, tt.taskNum); got != tt.want {
-				t.Errorf("containsTaskNumber(%q, %d) = %v, want %v", tt.title, tt.taskNum, got, tt.want)
+			if got := containsTaskNumber(task.DefaultMatcher(), "", tt.title, tt.taskNum); got != tt.want {
+				t.Errorf("containsTaskNumber(task.DefaultMatcher(), %q, %d) = %v, want %v", tt.title, tt.taskNum, got, tt.want)
 			}
 		})
 	}
@@ -99,10 +101,10 @@ func TestResolveTaskNumber(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rawInput := "#" + strconv.Itoa(tt.taskNum)
-			result, err := ResolveTaskNumber(tt.taskNum, rawInput, tt.mrs)
+			result, err := ResolveTaskNumber(task.DefaultMatcher(), tt.taskNum, rawInput, tt.mrs)
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ResolveTaskNumber() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ResolveTaskNumber(task.DefaultMatcher(), ) error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -159,7 +161,7 @@ func TestResolutionResultFields(t *testing.T) {
 		{ID: 14977, IID: 3106, ProjectID: 253, Title: "#51706: Feature X"},
 	}
 
-	result, err := ResolveTaskNumber(51706, "#51706", mrs)
+	result, err := ResolveTaskNumber(task.DefaultMatcher(), 51706, "#51706", mrs)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -376,10 +378,10 @@ func TestResolveIdentifierWithMRs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ResolveIdentifierWithMRs(tt.input, tt.mrs)
+			result, err := ResolveIdentifierWithMRs(task.DefaultMatcher(), tt.input, tt.mrs)
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ResolveIdentifierWithMRs(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				t.Errorf("ResolveIdentifierWithMRs(task.DefaultMatcher(), %q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
 				return
 			}
 
@@ -415,7 +417,7 @@ func TestResolveIdentifierWithMRsGlobalIDFallback(t *testing.T) {
 	// Large number with no IID match should indicate fallback needed
 	// Note: The actual fallback requires a client call, so ResolveIdentifierWithMRs
 	// returns a specific error that callers can check
-	result, err := ResolveIdentifierWithMRs("14977", testMRs)
+	result, err := ResolveIdentifierWithMRs(task.DefaultMatcher(), "14977", testMRs)
 
 	// 14977 > 10000, so if no IID match is found, we need fallback
 	// Since there's no IID 14977 in testMRs, this should fail
@@ -497,10 +499,10 @@ func TestResolveUnified(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ResolveUnified(tt.value, tt.rawInput, tt.mrs)
+			result, err := ResolveUnified(task.DefaultMatcher(), tt.value, tt.rawInput, tt.mrs)
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ResolveUnified() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ResolveUnified(task.DefaultMatcher(), ) error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -529,7 +531,7 @@ func TestResolveUnified_MultipleIIDMatchesNoFallback(t *testing.T) {
 		{ID: 200, IID: 3106, ProjectID: 2, Title: "#3106: Same IID different project"},
 	}
 
-	_, err := ResolveUnified(3106, "3106", mrs)
+	_, err := ResolveUnified(task.DefaultMatcher(), 3106, "3106", mrs)
 	if err == nil {
 		t.Error("expected MultiMatchError, got nil")
 		return
@@ -568,3 +570,34 @@ func TestNeedsGlobalIDFallback(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveIdentifierWithMRsTaskRef(t *testing.T) {
+	tasks, err := task.NewMatcher(map[string]config.TaskPatterns{
+		"acme": {Branch: []string{`(?P<task>ABC-\d+)`}, Text: []string{`(?P<task>ABC-\d+)`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mrs := []gitlab.MergeRequest{
+		{ID: 1, IID: 10, ProjectID: 5, SourceBranch: "feature/ABC-123-login", Title: "Fix login", References: gitlab.References{Full: "acme/api!10"}},
+		{ID: 2, IID: 11, ProjectID: 5, SourceBranch: "fix-typo", Title: "ABC-124: Fix typo", References: gitlab.References{Full: "acme/api!11"}},
+	}
+
+	for ref, want := range map[string]int{"ABC-123": 1, "ABC-124": 2} {
+		result, err := ResolveIdentifierWithMRs(tasks, ref, mrs)
+		if err != nil {
+			t.Fatalf("%s: %v", ref, err)
+		}
+		if result.GlobalID != want || result.MatchType != "task#" {
+			t.Errorf("%s resolved to %+v, want MR %d", ref, result, want)
+		}
+	}
+
+	if _, err := ResolveIdentifierWithMRs(tasks, "ABC-999", mrs); err == nil {
+		t.Error("expected an error for a task without MR")
+	}
+	// Without a matching pattern the input stays invalid
+	if _, err := ResolveIdentifierWithMRs(task.DefaultMatcher(), "ABC-123", mrs); err == nil || !strings.Contains(err.Error(), "invalid identifier format") {
+		t.Errorf("expected an invalid identifier error, got %v", err)
+	}
+}
@@ -1,14 +1,19 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
 )
 
 var (
 	cfgFile string
 	verbose bool
+	dryRun  bool
 )
 
 var rootCmd = &cobra.Command{
@@ -18,6 +23,7 @@ var rootCmd = &cobra.Command{
 }
 
 func Execute() {
+	wrapDryRun(rootCmd)
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -26,4 +32,32 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default: ~/.gitlab-cli.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "resolve everything and print the requests that would be sent, without changing anything")
+}
+
+// newClient returns a GitLab client for cfg that honours --dry-run.
+func newClient(cfg *config.Config) *gitlab.Client {
+	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	if dryRun {
+		client.EnableDryRun(os.Stdout)
+	}
+	return client
+}
+
+// wrapDryRun makes cmd and its subcommands treat the first request blocked
+// by --dry-run as a successful end of the command.
+func wrapDryRun(cmd *cobra.Command) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			err := run(cmd, args)
+			if errors.Is(err, gitlab.ErrDryRun) {
+				fmt.Println("Dry run: no changes made")
+				return nil
+			}
+			return err
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		wrapDryRun(sub)
+	}
 }
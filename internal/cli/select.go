@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 // SelectEnabled returns true when the --select flag is set to a positive value.
@@ -45,8 +46,8 @@ func ResolveWithSelect(matches []gitlab.MergeRequest, selectIdx int, rawInput st
 // ResolveTaskNumberWithSelect wraps ResolveTaskNumber with --select flag handling.
 // If ResolveTaskNumber returns MultiMatchError and SelectEnabled() is true,
 // it attempts to resolve using the select index.
-func ResolveTaskNumberWithSelect(taskNum int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
-	result, err := ResolveTaskNumber(taskNum, rawInput, mrs)
+func ResolveTaskNumberWithSelect(tasks *task.Matcher, taskNum int, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+	result, err := ResolveTaskNumber(tasks, taskNum, rawInput, mrs)
 	if err == nil {
 		return result, nil
 	}
@@ -60,6 +61,22 @@ func ResolveTaskNumberWithSelect(taskNum int, rawInput string, mrs []gitlab.Merg
 	return nil, err
 }
 
+// ResolveTaskRefWithSelect wraps ResolveTaskRef with --select flag handling.
+func ResolveTaskRefWithSelect(tasks *task.Matcher, ref, rawInput string, mrs []gitlab.MergeRequest) (*ResolutionResult, error) {
+	result, err := ResolveTaskRef(tasks, ref, rawInput, mrs)
+	if err == nil {
+		result.MatchType = "task#"
+		return result, nil
+	}
+
+	var multiErr *MultiMatchError
+	if errors.As(err, &multiErr) && SelectEnabled() {
+		return ResolveWithSelect(multiErr.Matches, GetSelectIndex(), rawInput)
+	}
+
+	return nil, err
+}
+
 // ResolveIIDWithSelect wraps ResolveIID with --select flag handling.
 // If ResolveIID returns MultiMatchError and SelectEnabled() is true,
 // it attempts to resolve using the select index.
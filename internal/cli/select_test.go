@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 func TestResolveWithSelect(t *testing.T) {
@@ -229,10 +230,10 @@ func TestResolveTaskNumberWithSelect(t *testing.T) {
 			defer func() { selectIndex = original }()
 			selectIndex = tt.selectIdx
 
-			result, err := ResolveTaskNumberWithSelect(tt.taskNum, tt.rawInput, tt.mrs)
+			result, err := ResolveTaskNumberWithSelect(task.DefaultMatcher(), tt.taskNum, tt.rawInput, tt.mrs)
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("ResolveTaskNumberWithSelect() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ResolveTaskNumberWithSelect(task.DefaultMatcher(), ) error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+)
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Tag operations",
+}
+
+var tagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List project tags",
+	RunE:  runTagList,
+}
+
+var tagCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a tag",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTagCreate,
+}
+
+var tagDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a tag",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTagDelete,
+}
+
+var (
+	tagProject string
+	tagSearch  string
+	tagJSON    bool
+	tagRef     string
+	tagMessage string
+)
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagListCmd)
+	tagCmd.AddCommand(tagCreateCmd)
+	tagCmd.AddCommand(tagDeleteCmd)
+
+	tagCmd.PersistentFlags().StringVar(&tagProject, "project", "", "project ID or path (required)")
+	tagCmd.MarkPersistentFlagRequired("project")
+
+	tagListCmd.Flags().StringVar(&tagSearch, "search", "", "filter tags by name (^prefix and suffix$ supported)")
+	tagListCmd.Flags().BoolVar(&tagJSON, "json", false, "output as JSON")
+
+	tagCreateCmd.Flags().StringVar(&tagRef, "ref", "", "branch or commit to tag (required)")
+	tagCreateCmd.Flags().StringVarP(&tagMessage, "message", "m", "", "annotation message (creates an annotated tag)")
+	tagCreateCmd.MarkFlagRequired("ref")
+}
+
+func runTagList(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	tags, err := client.ListTags(tagProject, tagSearch)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		fmt.Println("No tags found")
+		return nil
+	}
+
+	if tagJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tags)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCOMMIT\tDATE\tMESSAGE")
+
+	for _, t := range tags {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			t.Name,
+			shortSHA(t.Commit.ID),
+			formatTimestamp(t.Commit.CommittedDate),
+			truncate(t.Message, 45),
+		)
+	}
+
+	w.Flush()
+	return nil
+}
+
+func runTagCreate(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	tag, err := client.CreateTag(tagProject, args[0], tagRef, tagMessage)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created tag %s at %s\n", tag.Name, shortSHA(tag.Commit.ID))
+	return nil
+}
+
+func runTagDelete(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	if err := client.DeleteTag(tagProject, args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted tag %s\n", args[0])
+	return nil
+}
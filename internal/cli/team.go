@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/reviewers"
+)
+
+// rotationFileName holds the review rotation of all configured teams.
+const rotationFileName = "rotation.json"
+
+var teamCmd = &cobra.Command{
+	Use:   "team",
+	Short: "Reviewer team operations",
+}
+
+var teamShowCmd = &cobra.Command{
+	Use:   "show <team>",
+	Short: "Show the review queue and load of a configured team",
+	Long: `Show the review queue of a team from the "teams" config: who is next for
+'mr create --reviewers-from', how many reviews each member was assigned,
+their open reviews and whether they are away.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTeamShow,
+}
+
+var teamShowJSON bool
+
+func init() {
+	rootCmd.AddCommand(teamCmd)
+	teamCmd.AddCommand(teamShowCmd)
+
+	teamShowCmd.Flags().BoolVar(&teamShowJSON, "json", false, "output as JSON")
+}
+
+// loadRoster returns the roster of the configured team name.
+func loadRoster(cfg *config.Config, name string) (*reviewers.Roster, error) {
+	team, ok := cfg.Teams[name]
+	if !ok {
+		names := make([]string, 0, len(cfg.Teams))
+		for n := range cfg.Teams {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("team '%s' not found: no teams configured", name)
+		}
+		return nil, fmt.Errorf("team '%s' not found (configured: %s)", name, strings.Join(names, ", "))
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, err
+	}
+	return reviewers.NewRoster(name, team, loc)
+}
+
+func rotationStatePath() (string, error) {
+	dir, err := getCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, rotationFileName), nil
+}
+
+// rosterUsers looks up the users of the roster's members and, with load,
+// their review load.
+func rosterUsers(client *gitlab.Client, roster *reviewers.Roster, load bool) (map[string]int, map[string]int, error) {
+	ids := make(map[string]int)
+	loads := make(map[string]int)
+	for _, m := range roster.Members {
+		u, err := client.GetUserByUsername(m.Username)
+		if err != nil {
+			return nil, nil, fmt.Errorf("team %s: %w", roster.Name, err)
+		}
+		ids[m.Username] = u.ID
+		if !load {
+			continue
+		}
+		n, err := reviewers.ReviewLoad(client, u.ID, 0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("review load of %s: %w", m.Username, err)
+		}
+		loads[m.Username] = n
+	}
+	return ids, loads, nil
+}
+
+// teamPick is the next reviewers of a team for a new MR.
+type teamPick struct {
+	roster    *reviewers.Roster
+	usernames []string
+	ids       []int
+}
+
+// pickTeamReviewers returns the next reviewers of the team, never the
+// current user, who authors the MR.
+func pickTeamReviewers(cfg *config.Config, client *gitlab.Client, team string) (*teamPick, error) {
+	roster, err := loadRoster(cfg, team)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := client.GetCurrentUser()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := rotationStatePath()
+	if err != nil {
+		return nil, err
+	}
+	state, err := reviewers.LoadRotation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, loads, err := rosterUsers(client, roster, roster.Strategy == reviewers.LeastLoaded)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := roster.Queue(state.Team(team), loads, time.Now())
+	next := reviewers.Next(queue, roster.Reviewers, author.Username)
+	if len(next) == 0 {
+		return nil, fmt.Errorf("team %s has no available reviewer besides you", team)
+	}
+
+	pick := &teamPick{roster: roster}
+	for _, e := range next {
+		pick.usernames = append(pick.usernames, e.Username)
+		pick.ids = append(pick.ids, ids[e.Username])
+	}
+	return pick, nil
+}
+
+// record advances the team's rotation past the picked reviewers.
+func (p *teamPick) record() error {
+	path, err := rotationStatePath()
+	if err != nil {
+		return err
+	}
+	// Reload so that concurrent invocations lose as little as possible
+	state, err := reviewers.LoadRotation(path)
+	if err != nil {
+		return err
+	}
+	state.Team(p.roster.Name).Record(p.usernames, time.Now())
+	return state.Save(path)
+}
+
+func runTeamShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	roster, err := loadRoster(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	path, err := rotationStatePath()
+	if err != nil {
+		return err
+	}
+	state, err := reviewers.LoadRotation(path)
+	if err != nil {
+		return err
+	}
+
+	_, loads, err := rosterUsers(client, roster, true)
+	if err != nil {
+		return err
+	}
+
+	queue := roster.Queue(state.Team(roster.Name), loads, time.Now())
+
+	if teamShowJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Team      string                 `json:"team"`
+			Strategy  string                 `json:"strategy"`
+			Reviewers int                    `json:"reviewers"`
+			Queue     []reviewers.QueueEntry `json:"queue"`
+		}{roster.Name, roster.Strategy, roster.Reviewers, queue})
+	}
+
+	fmt.Printf("Team %s: %s, %d reviewer(s) per MR\n\n", roster.Name, roster.Strategy, roster.Reviewers)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tUSER\tWEIGHT\tASSIGNED\tLAST ASSIGNED\tLOAD\tSTATUS")
+	for i, e := range queue {
+		last := "-"
+		if !e.LastAt.IsZero() {
+			last = e.LastAt.In(loc).Format("2006-01-02 15:04")
+		}
+		status := "available"
+		if e.Away() {
+			status = "away, back " + e.AwayUntil.In(loc).Format("2006-01-02")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%s\t%d\t%s\n", i+1, e.Username, e.Weight, e.Assigned, last, e.Load, status)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestPickTeamReviewers(t *testing.T) {
+	cacheDir = t.TempDir()
+	defer func() { cacheDir = "" }()
+
+	ids := map[string]int{"alice": 1, "bob": 2, "carol": 3}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/user":
+			json.NewEncoder(w).Encode(gitlab.User{ID: 1, Username: "alice"})
+		case "/api/v4/users":
+			name := r.URL.Query().Get("username")
+			json.NewEncoder(w).Encode([]gitlab.User{{ID: ids[name], Username: name}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{
+		GitLabURL:   srv.URL,
+		GitLabToken: "token",
+		Teams: map[string]config.Team{
+			"backend": {Members: []config.TeamMember{{Username: "alice"}, {Username: "bob"}, {Username: "carol"}}},
+		},
+	}
+	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+
+	// alice creates the MRs, so bob and carol take turns
+	var picked []string
+	for range 3 {
+		pick, err := pickTeamReviewers(cfg, client, "backend")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pick.ids) != 1 || pick.ids[0] != ids[pick.usernames[0]] {
+			t.Fatalf("unexpected pick %+v", pick)
+		}
+		picked = append(picked, pick.usernames[0])
+		if err := pick.record(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := []string{"bob", "carol", "bob"}; !slices.Equal(picked, want) {
+		t.Errorf("picked %v, want %v", picked, want)
+	}
+
+	if _, err := pickTeamReviewers(cfg, client, "frontend"); err == nil {
+		t.Error("expected error for unknown team")
+	}
+}
@@ -25,6 +25,7 @@ var userListCmd = &cobra.Command{
 var (
 	userSearch  string
 	userProject string
+	userGroup   string
 	userLimit   int
 	userJSON    bool
 )
@@ -35,6 +36,8 @@ func init() {
 
 	userListCmd.Flags().StringVar(&userSearch, "search", "", "filter by name, username, or email")
 	userListCmd.Flags().StringVar(&userProject, "project", "", "list only project members")
+	userListCmd.Flags().StringVar(&userGroup, "group", "", "list only group members (including inherited)")
+	userListCmd.MarkFlagsMutuallyExclusive("project", "group")
 	userListCmd.Flags().IntVar(&userLimit, "limit", 20, "number of results")
 	userListCmd.Flags().BoolVar(&userJSON, "json", false, "output as JSON")
 }
@@ -49,13 +52,16 @@ func runUserList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
+	client := newClient(cfg)
 
 	var users []gitlab.User
 
-	if userProject != "" {
+	switch {
+	case userProject != "":
 		users, err = client.ListProjectMembers(userProject, userSearch)
-	} else {
+	case userGroup != "":
+		users, err = client.ListGroupMembers(userGroup, userSearch)
+	default:
 		users, err = client.ListUsers(gitlab.ListUsersOptions{
 			Search:  userSearch,
 			PerPage: userLimit,
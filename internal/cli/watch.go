@@ -0,0 +1,434 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/webhook"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [mr-id...]",
+	Short: "Watch merge requests and report changes as they happen",
+	Long: `Poll a set of merge requests and report every change: state, pipeline
+status, detailed merge status, rebases, labels, approvals and new comments.
+
+The set is the given MRs, the MRs assigned to you (--mine), the MRs you are
+reviewing (--reviewing), or a combination. With --mine or --reviewing the list
+is refreshed on every poll, so new MRs are picked up and merged or closed ones
+are reported one last time and dropped.
+
+Events are printed to the terminal. With --notify they are also sent as
+desktop notifications through notify-send, and with --exec each event is
+passed as a JSON object on stdin to a shell command:
+
+  gitlab-cli watch --mine --exec 'jq -r .message >> ~/mr-events.log'
+
+With --webhook the GitLab webhook receiver (see webhook serve) runs in-process
+and an MR is re-checked as soon as GitLab reports a change of it, so a long
+--interval is enough.
+
+Defaults come from the watch section of the config file:
+
+  watch:
+    interval: 30s
+    notify: true
+    exec: ""`,
+	RunE: runWatch,
+}
+
+var (
+	watchMine      bool
+	watchReviewing bool
+	watchInterval  time.Duration
+	watchNotify    bool
+	watchExec      string
+	watchWebhook   string
+)
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().BoolVar(&watchMine, "mine", false, "watch open MRs assigned to me")
+	watchCmd.Flags().BoolVar(&watchReviewing, "reviewing", false, "watch open MRs I am a reviewer of")
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 0, "time between polls (default from config, 30s)")
+	watchCmd.Flags().BoolVar(&watchNotify, "notify", false, "send desktop notifications with notify-send")
+	watchCmd.Flags().StringVar(&watchExec, "exec", "", "shell command to run for each event, with the event as JSON on stdin")
+	watchCmd.Flags().StringVar(&watchWebhook, "webhook", "", "also receive GitLab webhooks on this address, e.g. :8088")
+}
+
+// mrSnapshot is the watched state of one MR at one poll.
+type mrSnapshot struct {
+	MR        gitlab.MergeRequest
+	Approvers []string
+	NoteIDs   map[int]bool
+	Notes     []gitlab.Note
+}
+
+// watchEvent is one observed change. It is also the JSON payload of --exec.
+type watchEvent struct {
+	Type      string    `json:"type"`
+	Time      time.Time `json:"time"`
+	ProjectID int       `json:"project_id"`
+	MRIID     int       `json:"mr_iid"`
+	Title     string    `json:"title"`
+	WebURL    string    `json:"web_url"`
+	Before    string    `json:"before,omitempty"`
+	After     string    `json:"after,omitempty"`
+	Author    string    `json:"author,omitempty"`
+	Message   string    `json:"message"`
+}
+
+// watchKey identifies an MR across projects.
+type watchKey struct {
+	ProjectID int
+	IID       int
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && !watchMine && !watchReviewing {
+		return fmt.Errorf("give MR identifiers or use --mine or --reviewing")
+	}
+
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	interval := cfg.WatchInterval
+	if cmd.Flags().Changed("interval") {
+		interval = watchInterval
+	}
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	notify := cfg.WatchNotify
+	if cmd.Flags().Changed("notify") {
+		notify = watchNotify
+	}
+	hook := cfg.WatchExec
+	if cmd.Flags().Changed("exec") {
+		hook = watchExec
+	}
+
+	if notify {
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return fmt.Errorf("--notify needs notify-send in PATH")
+		}
+	}
+
+	var explicit []watchKey
+	for _, ref := range args {
+		result, err := ResolveIdentifier(client, taskMatcher(cfg), ref)
+		if err != nil {
+			return err
+		}
+		mr, err := client.GetMRByGlobalID(result.GlobalID)
+		if err != nil {
+			return err
+		}
+		explicit = append(explicit, watchKey{mr.ProjectID, mr.IID})
+	}
+
+	reviewerID := 0
+	if watchReviewing {
+		me, err := client.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+		reviewerID = me.ID
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	emit := func(e watchEvent) {
+		fmt.Printf("%s !%d %s: %s\n", e.Time.Format("15:04:05"), e.MRIID, truncate(e.Title, 40), e.Message)
+		if notify {
+			if err := sendNotification(e); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: notify-send failed: %v\n", err)
+			}
+		}
+		if hook != "" {
+			if err := runEventHook(ctx, hook, e); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: --exec hook failed: %v\n", err)
+			}
+		}
+	}
+
+	// Webhook deliveries for an MR wake the loop; the buffer absorbs bursts
+	// such as a pipeline and a merge status change arriving together.
+	var wake chan webhook.Event
+	if watchWebhook != "" {
+		wake = make(chan webhook.Event, 16)
+		_, err := webhook.Listen(ctx, watchWebhook, newWebhookHandler(cfg.WebhookSecret, taskMatcher(cfg), func(e webhook.Event) {
+			if e.MRIID == 0 {
+				return
+			}
+			select {
+			case wake <- e:
+			default:
+			}
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshots := make(map[watchKey]*mrSnapshot)
+
+	poll := func(keys []watchKey) {
+		for _, key := range keys {
+			cur, err := takeSnapshot(client, key)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: !%d (project %d): %v\n", key.IID, key.ProjectID, err)
+				continue
+			}
+
+			if prev, ok := snapshots[key]; ok {
+				for _, e := range diffSnapshots(prev, cur, time.Now()) {
+					emit(e)
+				}
+			}
+			snapshots[key] = cur
+
+			if cur.MR.State != "opened" && !slices.Contains(explicit, key) {
+				delete(snapshots, key)
+			}
+		}
+	}
+
+	refresh := func() {
+		keys, err := watchTargets(client, explicit, watchMine, reviewerID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: listing merge requests: %v\n", err)
+			return
+		}
+
+		// MRs that left the list were merged or closed (or unassigned); poll
+		// them once more so the final change is reported.
+		for key := range snapshots {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+		poll(keys)
+	}
+
+	refresh()
+	fmt.Printf("Watching %d merge request(s) every %s (Ctrl+C to stop)\n", len(snapshots), interval)
+	if watchWebhook != "" {
+		fmt.Printf("Receiving webhooks on %s\n", watchWebhook)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return nil
+		case <-ticker.C:
+			refresh()
+		case e := <-wake:
+			key := watchKey{e.ProjectID, e.MRIID}
+			if _, ok := snapshots[key]; ok {
+				poll([]watchKey{key})
+			} else if e.Kind == "merge_request" && (watchMine || watchReviewing) {
+				// Possibly a new MR of mine or for me to review
+				refresh()
+			}
+		}
+	}
+}
+
+// watchTargets returns the MRs to poll: the explicit ones plus the open MRs
+// assigned to me or with reviewerID as a reviewer.
+func watchTargets(client *gitlab.Client, explicit []watchKey, mine bool, reviewerID int) ([]watchKey, error) {
+	keys := slices.Clone(explicit)
+
+	add := func(opts gitlab.ListMROptions) error {
+		opts.State = "opened"
+		mrs, err := client.ListMRs(opts)
+		if err != nil {
+			return err
+		}
+		for _, mr := range mrs {
+			key := watchKey{mr.ProjectID, mr.IID}
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+		return nil
+	}
+
+	if mine {
+		if err := add(gitlab.ListMROptions{Scope: "assigned_to_me"}); err != nil {
+			return nil, err
+		}
+	}
+	if reviewerID > 0 {
+		if err := add(gitlab.ListMROptions{Scope: "all", ReviewerID: reviewerID}); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+func takeSnapshot(client *gitlab.Client, key watchKey) (*mrSnapshot, error) {
+	mr, err := client.GetMR(key.ProjectID, key.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, err := client.GetMRApprovals(key.ProjectID, key.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	discussions, err := client.GetMRDiscussions(key.ProjectID, key.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &mrSnapshot{MR: *mr, NoteIDs: make(map[int]bool)}
+	for _, a := range approvals.Approvers {
+		snap.Approvers = append(snap.Approvers, a.User.Username)
+	}
+	sort.Strings(snap.Approvers)
+
+	for _, d := range discussions {
+		for _, n := range d.Notes {
+			if n.System {
+				continue
+			}
+			snap.NoteIDs[n.ID] = true
+			snap.Notes = append(snap.Notes, n)
+		}
+	}
+	return snap, nil
+}
+
+// diffSnapshots lists the changes from prev to cur.
+func diffSnapshots(prev, cur *mrSnapshot, now time.Time) []watchEvent {
+	var events []watchEvent
+	add := func(typ, before, after, author, message string) {
+		events = append(events, watchEvent{
+			Type:      typ,
+			Time:      now,
+			ProjectID: cur.MR.ProjectID,
+			MRIID:     cur.MR.IID,
+			Title:     cur.MR.Title,
+			WebURL:    cur.MR.WebURL,
+			Before:    before,
+			After:     after,
+			Author:    author,
+			Message:   message,
+		})
+	}
+
+	p, c := prev.MR, cur.MR
+
+	if p.State != c.State {
+		add("state", p.State, c.State, "", c.State)
+	}
+
+	if before, after := pipelineStatus(p.HeadPipeline), pipelineStatus(c.HeadPipeline); before != after && after != "" {
+		add("pipeline", before, after, "", "pipeline "+after)
+	}
+
+	if p.DetailedMergeStatus != c.DetailedMergeStatus && c.State == "opened" {
+		add("merge_status", p.DetailedMergeStatus, c.DetailedMergeStatus, "", "merge status "+c.DetailedMergeStatus)
+	}
+
+	if p.RebaseInProgress && !c.RebaseInProgress {
+		if c.MergeError != "" {
+			add("rebase", "", "failed", "", "rebase failed: "+c.MergeError)
+		} else {
+			add("rebase", "", "finished", "", "rebase finished")
+		}
+	}
+
+	for _, l := range c.Labels {
+		if !slices.Contains(p.Labels, l) {
+			add("label_added", "", l, "", "label added: "+l)
+		}
+	}
+	for _, l := range p.Labels {
+		if !slices.Contains(c.Labels, l) {
+			add("label_removed", l, "", "", "label removed: "+l)
+		}
+	}
+
+	for _, u := range cur.Approvers {
+		if !slices.Contains(prev.Approvers, u) {
+			add("approved", "", "", u, "approved by @"+u)
+		}
+	}
+	for _, u := range prev.Approvers {
+		if !slices.Contains(cur.Approvers, u) {
+			add("unapproved", "", "", u, "approval revoked by @"+u)
+		}
+	}
+
+	for _, n := range cur.Notes {
+		if prev.NoteIDs[n.ID] {
+			continue
+		}
+		add("note", "", "", n.Author.Username, fmt.Sprintf("@%s commented: %s", n.Author.Username, truncate(firstLine(n.Body), 60)))
+	}
+
+	return events
+}
+
+func pipelineStatus(p *gitlab.Pipeline) string {
+	if p == nil {
+		return ""
+	}
+	return p.Status
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}
+
+func sendNotification(e watchEvent) error {
+	summary := fmt.Sprintf("!%d %s", e.MRIID, e.Title)
+	return exec.Command("notify-send", "--app-name=gitlab-cli", summary, e.Message).Run()
+}
+
+// runEventHook runs an --exec command through sh with the event as JSON on
+// stdin.
+func runEventHook(ctx context.Context, hook string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", hook)
+	cmd.Stdin = bytes.NewReader(append(payload, '\n'))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
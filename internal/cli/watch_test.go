@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func testSnapshot(mr gitlab.MergeRequest, approvers []string, notes ...gitlab.Note) *mrSnapshot {
+	snap := &mrSnapshot{MR: mr, Approvers: approvers, NoteIDs: make(map[int]bool), Notes: notes}
+	for _, n := range notes {
+		snap.NoteIDs[n.ID] = true
+	}
+	return snap
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	base := gitlab.MergeRequest{
+		IID:                 7,
+		ProjectID:           42,
+		Title:               "Add cache",
+		State:               "opened",
+		DetailedMergeStatus: "ci_still_running",
+		HeadPipeline:        &gitlab.Pipeline{Status: "running"},
+		Labels:              []string{"backend", "review"},
+	}
+	note := gitlab.Note{ID: 1, Author: gitlab.User{Username: "alice"}, Body: "LGTM"}
+
+	tests := []struct {
+		name   string
+		change func(mr *gitlab.MergeRequest)
+		apprs  []string
+		notes  []gitlab.Note
+		want   []string
+	}{
+		{
+			name:   "no change",
+			change: func(mr *gitlab.MergeRequest) {},
+			want:   nil,
+		},
+		{
+			name: "pipeline and merge status",
+			change: func(mr *gitlab.MergeRequest) {
+				mr.HeadPipeline = &gitlab.Pipeline{Status: "success"}
+				mr.DetailedMergeStatus = "mergeable"
+			},
+			want: []string{"pipeline running→success", "merge_status ci_still_running→mergeable"},
+		},
+		{
+			name: "merged",
+			change: func(mr *gitlab.MergeRequest) {
+				mr.State = "merged"
+				mr.DetailedMergeStatus = "not_open"
+			},
+			want: []string{"state opened→merged"},
+		},
+		{
+			name:   "labels",
+			change: func(mr *gitlab.MergeRequest) { mr.Labels = []string{"backend", "approved"} },
+			want:   []string{"label_added →approved", "label_removed review→"},
+		},
+		{
+			name:   "approvals and notes",
+			change: func(mr *gitlab.MergeRequest) {},
+			apprs:  []string{"alice"},
+			notes:  []gitlab.Note{note, {ID: 2, Author: gitlab.User{Username: "bob"}, Body: "Why?\nDetails"}},
+			want:   []string{"approved alice", "unapproved bob", "note bob"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cur := base
+			cur.Labels = append([]string(nil), base.Labels...)
+			tt.change(&cur)
+
+			apprs := tt.apprs
+			if apprs == nil {
+				apprs = []string{"bob"}
+			}
+			notes := tt.notes
+			if notes == nil {
+				notes = []gitlab.Note{note}
+			}
+
+			prev := testSnapshot(base, []string{"bob"}, note)
+			events := diffSnapshots(prev, testSnapshot(cur, apprs, notes...), now)
+
+			var got []string
+			for _, e := range events {
+				if e.MRIID != 7 || e.ProjectID != 42 || !e.Time.Equal(now) {
+					t.Errorf("event not attributed to the MR: %+v", e)
+				}
+				switch {
+				case e.Author != "":
+					got = append(got, e.Type+" "+e.Author)
+				default:
+					got = append(got, fmt.Sprintf("%s %s→%s", e.Type, e.Before, e.After))
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("events = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffSnapshotsRebase(t *testing.T) {
+	prev := testSnapshot(gitlab.MergeRequest{IID: 1, State: "opened", RebaseInProgress: true}, nil)
+
+	done := testSnapshot(gitlab.MergeRequest{IID: 1, State: "opened"}, nil)
+	if events := diffSnapshots(prev, done, time.Now()); len(events) != 1 || events[0].Message != "rebase finished" {
+		t.Errorf("finished rebase: %+v", events)
+	}
+
+	failed := testSnapshot(gitlab.MergeRequest{IID: 1, State: "opened", MergeError: "conflict"}, nil)
+	if events := diffSnapshots(prev, failed, time.Now()); len(events) != 1 || events[0].Message != "rebase failed: conflict" {
+		t.Errorf("failed rebase: %+v", events)
+	}
+}
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
+	"github.com/user/gitlab-cli/internal/webhook"
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Receive GitLab webhooks instead of polling",
+}
+
+var webhookServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Receive merge request, pipeline, note and push webhooks",
+	Long: `Listen for GitLab webhook deliveries and print each one as an activity
+event. Deliveries must carry the configured secret in X-Gitlab-Token.
+
+With --exec every event is passed as a JSON object on stdin to a shell command.
+The object has the fields of activity list --json plus kind, project_id,
+project_path and mr_iid.
+
+watch and mr merge accept --webhook <addr> to run the same receiver in-process
+and re-check an MR as soon as GitLab reports a change, instead of waiting for
+the next poll.`,
+	RunE: runWebhookServe,
+}
+
+var webhookRegisterCmd = &cobra.Command{
+	Use:   "register <project>",
+	Short: "Create or update the project webhook pointing at a receiver",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWebhookRegister,
+}
+
+var (
+	webhookListen   string
+	webhookSecret   string
+	webhookExec     string
+	webhookJSON     bool
+	webhookURL      string
+	webhookEvents   []string
+	webhookInsecure bool
+)
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookServeCmd)
+	webhookCmd.AddCommand(webhookRegisterCmd)
+
+	webhookServeCmd.Flags().StringVar(&webhookListen, "listen", "", "address to listen on (default from config, :8088)")
+	webhookServeCmd.Flags().StringVar(&webhookSecret, "secret", "", "expected X-Gitlab-Token (default from config or GITLAB_WEBHOOK_SECRET)")
+	webhookServeCmd.Flags().StringVar(&webhookExec, "exec", "", "shell command to run for each event, with the event as JSON on stdin")
+	webhookServeCmd.Flags().BoolVar(&webhookJSON, "json", false, "print events as JSON lines")
+
+	webhookRegisterCmd.Flags().StringVar(&webhookURL, "url", "", "public URL of the receiver (required)")
+	webhookRegisterCmd.Flags().StringVar(&webhookSecret, "secret", "", "secret token GitLab sends (default from config or GITLAB_WEBHOOK_SECRET)")
+	webhookRegisterCmd.Flags().StringSliceVar(&webhookEvents, "events", []string{"mr", "pipeline", "note", "push"}, "event types: mr, pipeline, note, push")
+	webhookRegisterCmd.Flags().BoolVar(&webhookInsecure, "insecure", false, "disable TLS certificate verification of the receiver")
+	webhookRegisterCmd.MarkFlagRequired("url")
+}
+
+func runWebhookServe(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	listen := cfg.WebhookListen
+	if webhookListen != "" {
+		listen = webhookListen
+	}
+	secret := cfg.WebhookSecret
+	if webhookSecret != "" {
+		secret = webhookSecret
+	}
+	loc, err := cfg.Location()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	events := make(chan webhook.Event, 64)
+	handler := newWebhookHandler(secret, taskMatcher(cfg), func(e webhook.Event) {
+		select {
+		case events <- e:
+		default:
+			fmt.Fprintf(os.Stderr, "Warning: dropping %s event, handler is falling behind\n", e.Kind)
+		}
+	})
+	handler.Location = loc
+	errc, err := webhook.Listen(ctx, listen, handler)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Listening for GitLab webhooks on %s (Ctrl+C to stop)\n", listen)
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errc:
+			return err
+		case e := <-events:
+			if webhookJSON {
+				enc.Encode(e)
+			} else {
+				fmt.Println(formatWebhookEvent(e))
+			}
+			if webhookExec != "" {
+				if err := runEventHook(ctx, webhookExec, e); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: --exec hook failed: %v\n", err)
+				}
+			}
+		}
+	}
+}
+
+// newWebhookHandler returns a receiver that fills in the task of each event
+// before passing it on. Without a secret it warns that anyone who can reach
+// the listener can post events.
+func newWebhookHandler(secret string, tasks *task.Matcher, onEvent func(webhook.Event)) *webhook.Handler {
+	if secret == "" {
+		fmt.Fprintln(os.Stderr, "Warning: no webhook secret configured; accepting unauthenticated deliveries")
+	}
+	return &webhook.Handler{
+		Secret: secret,
+		OnEvent: func(e webhook.Event) {
+			e.Task = tasks.FromBranch(e.ProjectPath, e.Source)
+			if title, ok := e.Details["title"].(string); ok && e.Task == "" {
+				e.Task = tasks.FromText(e.ProjectPath, title)
+			}
+			onEvent(e)
+		},
+		OnError: func(err error) {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		},
+	}
+}
+
+func formatWebhookEvent(e webhook.Event) string {
+	line := fmt.Sprintf("%s %s  %s: %s", e.Date, e.Time, e.Project, e.Type)
+	if e.Description != "" {
+		line += " " + e.Description
+	}
+	if e.Task != "" {
+		line += " [" + e.Task + "]"
+	}
+	return line
+}
+
+func runWebhookRegister(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load(cfgFile)
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	client := newClient(cfg)
+
+	opts := gitlab.ProjectHookOptions{
+		URL:                   webhookURL,
+		Token:                 cfg.WebhookSecret,
+		EnableSSLVerification: !webhookInsecure,
+	}
+	if webhookSecret != "" {
+		opts.Token = webhookSecret
+	}
+	for _, e := range webhookEvents {
+		switch strings.TrimSpace(e) {
+		case "mr", "merge_request":
+			opts.MergeRequestsEvents = true
+		case "pipeline":
+			opts.PipelineEvents = true
+		case "note":
+			opts.NoteEvents = true
+		case "push":
+			opts.PushEvents = true
+		default:
+			return fmt.Errorf("unknown event type '%s' (use mr, pipeline, note, push)", e)
+		}
+	}
+	if opts.Token == "" {
+		fmt.Fprintln(os.Stderr, "Warning: registering a hook without a secret token")
+	}
+
+	hooks, err := client.ListProjectHooks(args[0])
+	if err != nil {
+		return err
+	}
+
+	for _, h := range hooks {
+		if h.URL != webhookURL {
+			continue
+		}
+		hook, err := client.UpdateProjectHook(args[0], h.ID, opts)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Updated hook %d: %s\n", hook.ID, hook.URL)
+		return nil
+	}
+
+	hook, err := client.CreateProjectHook(args[0], opts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created hook %d: %s\n", hook.ID, hook.URL)
+	return nil
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/task"
+	"github.com/user/gitlab-cli/internal/webhook"
+)
+
+func TestNewWebhookHandlerWarnsWithoutSecret(t *testing.T) {
+	for secret, warn := range map[string]bool{"": true, "s3cret": false} {
+		oldStderr := os.Stderr
+		r, w, _ := os.Pipe()
+		os.Stderr = w
+
+		newWebhookHandler(secret, task.DefaultMatcher(), func(webhook.Event) {})
+
+		w.Close()
+		os.Stderr = oldStderr
+
+		var buf bytes.Buffer
+		io.Copy(&buf, r)
+		if got := strings.Contains(buf.String(), "no webhook secret"); got != warn {
+			t.Errorf("secret %q: warning = %q", secret, buf.String())
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -15,6 +16,64 @@ type Config struct {
 	MaxRetries   int
 	Timeout      time.Duration
 	PollInterval time.Duration
+
+	// CheckoutBranchPattern names the local branch created by `mr checkout`.
+	// Supports {iid}, {source_branch}, {author} and {project_id}.
+	CheckoutBranchPattern string
+
+	// Watch* are the defaults of `watch`: how often to poll, whether to send
+	// desktop notifications, and a shell command that receives each event
+	// as JSON on stdin.
+	WatchInterval time.Duration
+	WatchNotify   bool
+	WatchExec     string
+
+	// WebhookListen is the address `webhook serve` listens on and
+	// WebhookSecret the token GitLab must send in X-Gitlab-Token.
+	WebhookListen string
+	WebhookSecret string
+
+	// Timesheet* tune how `activity timesheet` turns events into time: a
+	// pause longer than TimesheetGap ends a work session, the first event of
+	// a session is credited TimesheetFirstEvent of preceding work, and daily
+	// totals are rounded to TimesheetRound ("up", "nearest" or "down").
+	TimesheetGap        time.Duration
+	TimesheetFirstEvent time.Duration
+	TimesheetRound      time.Duration
+	TimesheetRounding   string
+
+	// Timezone is the IANA zone (e.g. "Europe/Prague") in which activity
+	// dates and day boundaries are computed; empty means the local zone.
+	Timezone string
+
+	// Tasks holds the task reference patterns by scope: "default", a group
+	// or project path, or a project ID. See the task package.
+	Tasks map[string]TaskPatterns
+
+	// Stale* are the policy of `mr stale`: an MR without activity for
+	// StaleDays (drafts: StaleDraftDays) or more than StaleBehind commits
+	// behind its target is stale. StaleLabel marks it, StaleComment is the
+	// reminder posted on it, and it may be closed StaleGraceDays after being
+	// marked. MRs with one of StaleExcludeLabels are left alone.
+	StaleDays          int
+	StaleDraftDays     int
+	StaleBehind        int
+	StaleLabel         string
+	StaleComment       string
+	StaleGraceDays     int
+	StaleExcludeLabels []string
+
+	// Teams are the reviewer rosters used by `mr create --reviewers-from`,
+	// `team show` and the --team flags, by name.
+	Teams map[string]Team
+}
+
+// TaskPatterns are the ordered regexes that find task references in branch
+// names and in text (titles, commit messages). An empty list inherits the
+// patterns of the enclosing scope.
+type TaskPatterns struct {
+	Branch []string `mapstructure:"branch"`
+	Text   []string `mapstructure:"text"`
 }
 
 func Load(cfgFile string) (*Config, error) {
@@ -24,6 +83,16 @@ func Load(cfgFile string) (*Config, error) {
 	v.SetDefault("max_retries", 3)
 	v.SetDefault("timeout", "5m")
 	v.SetDefault("poll_interval", "5s")
+	v.SetDefault("checkout.branch_pattern", "{source_branch}")
+	v.SetDefault("watch.interval", "30s")
+	v.SetDefault("webhook.listen", ":8088")
+	v.SetDefault("timesheet.gap", "1h")
+	v.SetDefault("timesheet.first_event", "30m")
+	v.SetDefault("timesheet.round", "15m")
+	v.SetDefault("timesheet.rounding", "up")
+	v.SetDefault("stale.days", 30)
+	v.SetDefault("stale.label", "stale")
+	v.SetDefault("stale.grace_days", 14)
 
 	// Environment variables
 	v.SetEnvPrefix("")
@@ -31,6 +100,7 @@ func Load(cfgFile string) (*Config, error) {
 	v.BindEnv("gitlab_token", "GITLAB_TOKEN")
 	v.BindEnv("max_retries", "GITLAB_CLI_MAX_RETRIES")
 	v.BindEnv("timeout", "GITLAB_CLI_TIMEOUT")
+	v.BindEnv("webhook.secret", "GITLAB_WEBHOOK_SECRET")
 	v.AutomaticEnv()
 
 	// Config file
@@ -58,12 +128,64 @@ func Load(cfgFile string) (*Config, error) {
 		pollInterval = 5 * time.Second
 	}
 
+	watchInterval, err := time.ParseDuration(v.GetString("watch.interval"))
+	if err != nil {
+		watchInterval = 30 * time.Second
+	}
+
+	timesheetGap, err := time.ParseDuration(v.GetString("timesheet.gap"))
+	if err != nil {
+		timesheetGap = time.Hour
+	}
+
+	timesheetFirstEvent, err := time.ParseDuration(v.GetString("timesheet.first_event"))
+	if err != nil {
+		timesheetFirstEvent = 30 * time.Minute
+	}
+
+	timesheetRound, err := time.ParseDuration(v.GetString("timesheet.round"))
+	if err != nil {
+		timesheetRound = 15 * time.Minute
+	}
+
 	cfg := &Config{
 		GitLabURL:    v.GetString("gitlab_url"),
 		GitLabToken:  v.GetString("gitlab_token"),
 		MaxRetries:   v.GetInt("max_retries"),
 		Timeout:      timeout,
 		PollInterval: pollInterval,
+
+		CheckoutBranchPattern: v.GetString("checkout.branch_pattern"),
+
+		WatchInterval: watchInterval,
+		WatchNotify:   v.GetBool("watch.notify"),
+		WatchExec:     v.GetString("watch.exec"),
+
+		WebhookListen: v.GetString("webhook.listen"),
+		WebhookSecret: v.GetString("webhook.secret"),
+
+		TimesheetGap:        timesheetGap,
+		TimesheetFirstEvent: timesheetFirstEvent,
+		TimesheetRound:      timesheetRound,
+		TimesheetRounding:   v.GetString("timesheet.rounding"),
+
+		Timezone: v.GetString("timezone"),
+
+		StaleDays:          v.GetInt("stale.days"),
+		StaleDraftDays:     v.GetInt("stale.draft_days"),
+		StaleBehind:        v.GetInt("stale.behind"),
+		StaleLabel:         v.GetString("stale.label"),
+		StaleComment:       v.GetString("stale.comment"),
+		StaleGraceDays:     v.GetInt("stale.grace_days"),
+		StaleExcludeLabels: v.GetStringSlice("stale.exclude_labels"),
+	}
+
+	if err := v.UnmarshalKey("tasks", &cfg.Tasks); err != nil {
+		return nil, fmt.Errorf("reading tasks: %w", err)
+	}
+
+	if err := v.UnmarshalKey("teams", &cfg.Teams); err != nil {
+		return nil, fmt.Errorf("reading teams: %w", err)
 	}
 
 	return cfg, nil
@@ -79,6 +201,19 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// Location returns the configured time zone, or the local zone when none is
+// set.
+func (c *Config) Location() (*time.Location, error) {
+	if c.Timezone == "" || strings.EqualFold(c.Timezone, "local") {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': %w", c.Timezone, err)
+	}
+	return loc, nil
+}
+
 func DefaultConfigPath() string {
 	home, _ := os.UserHomeDir()
 	return filepath.Join(home, ".gitlab-cli.yaml")
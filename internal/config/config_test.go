@@ -2,7 +2,10 @@ package config
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoadFromEnv(t *testing.T) {
@@ -22,4 +25,70 @@ func TestLoadFromEnv(t *testing.T) {
 	if cfg.GitLabToken != "test-token" {
 		t.Errorf("expected token test-token, got %s", cfg.GitLabToken)
 	}
+	if cfg.WatchInterval != 30*time.Second {
+		t.Errorf("expected watch interval 30s, got %s", cfg.WatchInterval)
+	}
+}
+
+func TestReadTeamFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.txt")
+	if err := os.WriteFile(path, []byte("# backend\nalice\n\n@bob\n  carol  \n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	users, err := ReadTeamFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(users, ",") != "alice,bob,carol" {
+		t.Errorf("expected alice,bob,carol, got %v", users)
+	}
+}
+
+func TestLocation(t *testing.T) {
+	cfg := &Config{}
+	if loc, err := cfg.Location(); err != nil || loc != time.Local {
+		t.Errorf("expected local zone, got %v, %v", loc, err)
+	}
+
+	cfg.Timezone = "Mars/Olympus"
+	if _, err := cfg.Location(); err == nil {
+		t.Error("expected error for unknown zone")
+	}
+}
+
+func TestLoadTeams(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := `teams:
+  backend:
+    strategy: least-loaded
+    reviewers: 2
+    members:
+      - username: alice
+        weight: 2
+      - username: "@bob"
+        away: ["2024-07-01..2024-07-14"]
+`
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	team, ok := cfg.Teams["backend"]
+	if !ok {
+		t.Fatalf("team backend not loaded: %+v", cfg.Teams)
+	}
+	if team.Strategy != "least-loaded" || team.Reviewers != 2 || team.Members[0].Weight != 2 {
+		t.Errorf("unexpected team %+v", team)
+	}
+	if len(team.Members[1].Away) != 1 || team.Members[1].Away[0] != "2024-07-01..2024-07-14" {
+		t.Errorf("unexpected away periods %v", team.Members[1].Away)
+	}
+	if strings.Join(team.Usernames(), ",") != "alice,bob" {
+		t.Errorf("expected alice,bob, got %v", team.Usernames())
+	}
 }
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Team is a reviewer roster. Strategy is "round-robin" (the default) or
+// "least-loaded", and Reviewers the number of reviewers requested per MR
+// (default 1).
+type Team struct {
+	Strategy  string       `mapstructure:"strategy"`
+	Reviewers int          `mapstructure:"reviewers"`
+	Members   []TeamMember `mapstructure:"members"`
+}
+
+// TeamMember is a member of a roster. Weight is their share of reviews
+// relative to the others (default 1); Away lists days ("2024-07-01") or
+// inclusive periods ("2024-07-01..2024-07-14") they are out of office.
+type TeamMember struct {
+	Username string   `mapstructure:"username"`
+	Weight   int      `mapstructure:"weight"`
+	Away     []string `mapstructure:"away"`
+}
+
+// Usernames returns the usernames of the team's members.
+func (t Team) Usernames() []string {
+	users := make([]string, len(t.Members))
+	for i, m := range t.Members {
+		users[i] = strings.TrimPrefix(m.Username, "@")
+	}
+	return users
+}
+
+// ReadTeamFile reads a team file: one username per line, optionally with a
+// leading @. Blank lines and lines starting with # are ignored.
+func ReadTeamFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading team file: %w", err)
+	}
+	defer f.Close()
+
+	var users []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		users = append(users, strings.TrimPrefix(line, "@"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading team file: %w", err)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("team file %s lists no users", path)
+	}
+
+	return users, nil
+}
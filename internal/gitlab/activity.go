@@ -3,10 +3,51 @@ package gitlab
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// GetEvents returns the events of the token owner, or with opts.Users or
+// opts.ProjectID those of other users or a project. Events of several users
+// are merged newest first.
 func (c *Client) GetEvents(opts ListEventsOptions) ([]Event, error) {
+	if opts.ProjectID != "" {
+		events, err := c.listEvents(fmt.Sprintf("/projects/%s/events", url.PathEscape(opts.ProjectID)), opts)
+		if err != nil {
+			return nil, err
+		}
+		return filterEventAuthors(events, opts.Users), nil
+	}
+
+	if len(opts.Users) == 0 {
+		return c.listEvents("/events", opts)
+	}
+
+	var allEvents []Event
+	seen := make(map[string]bool, len(opts.Users))
+	for _, user := range opts.Users {
+		// A user given directly and as a team member is fetched once
+		if seen[strings.ToLower(user)] {
+			continue
+		}
+		seen[strings.ToLower(user)] = true
+
+		events, err := c.listEvents(fmt.Sprintf("/users/%s/events", url.PathEscape(user)), opts)
+		if err != nil {
+			return nil, fmt.Errorf("user %s: %w", user, err)
+		}
+		allEvents = append(allEvents, events...)
+	}
+	sort.SliceStable(allEvents, func(i, j int) bool {
+		return allEvents[i].CreatedAt > allEvents[j].CreatedAt
+	})
+
+	return allEvents, nil
+}
+
+func (c *Client) listEvents(base string, opts ListEventsOptions) ([]Event, error) {
 	params := url.Values{}
 	params.Set("per_page", "100")
 
@@ -22,7 +63,7 @@ func (c *Client) GetEvents(opts ListEventsOptions) ([]Event, error) {
 
 	for {
 		params.Set("page", fmt.Sprintf("%d", page))
-		path := "/events?" + params.Encode()
+		path := base + "?" + params.Encode()
 
 		var events []Event
 		if err := c.get(path, &events); err != nil {
@@ -44,6 +85,25 @@ func (c *Client) GetEvents(opts ListEventsOptions) ([]Event, error) {
 	return allEvents, nil
 }
 
+// filterEventAuthors keeps the events authored by one of users; an empty
+// list keeps all of them.
+func filterEventAuthors(events []Event, users []string) []Event {
+	if len(users) == 0 {
+		return events
+	}
+	keep := make(map[string]bool, len(users))
+	for _, u := range users {
+		keep[strings.ToLower(u)] = true
+	}
+	filtered := events[:0]
+	for _, e := range events {
+		if keep[strings.ToLower(e.AuthorUsername)] || keep[strconv.Itoa(e.AuthorID)] {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
 func (c *Client) GetProject(projectID int) (*Project, error) {
 	path := fmt.Sprintf("/projects/%d", projectID)
 
@@ -0,0 +1,63 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetEventsSelectors(t *testing.T) {
+	events := map[string][]Event{
+		"/api/v4/users/alice/events": {
+			{ID: 1, CreatedAt: "2024-05-01T09:00:00Z", AuthorUsername: "alice"},
+			{ID: 2, CreatedAt: "2024-05-01T07:00:00Z", AuthorUsername: "alice"},
+		},
+		"/api/v4/users/bob/events": {
+			{ID: 3, CreatedAt: "2024-05-01T08:00:00Z", AuthorUsername: "bob"},
+		},
+		"/api/v4/projects/group/api/events": {
+			{ID: 4, CreatedAt: "2024-05-01T10:00:00Z", AuthorUsername: "alice"},
+			{ID: 5, CreatedAt: "2024-05-01T09:30:00Z", AuthorUsername: "carol", AuthorID: 7},
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte("[]"))
+			return
+		}
+		json.NewEncoder(w).Encode(events[r.URL.Path])
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "token")
+
+	tests := []struct {
+		name string
+		opts ListEventsOptions
+		want []int
+	}{
+		{"users merged newest first", ListEventsOptions{Users: []string{"alice", "bob"}}, []int{1, 3, 2}},
+		{"duplicate users fetched once", ListEventsOptions{Users: []string{"alice", "bob", "alice", "BOB"}}, []int{1, 3, 2}},
+		{"project", ListEventsOptions{ProjectID: "group/api"}, []int{4, 5}},
+		{"project filtered by author", ListEventsOptions{ProjectID: "group/api", Users: []string{"Alice"}}, []int{4}},
+		{"project filtered by author ID", ListEventsOptions{ProjectID: "group/api", Users: []string{"7"}}, []int{5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.GetEvents(tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events, want %d", len(got), len(tt.want))
+			}
+			for i, id := range tt.want {
+				if got[i].ID != id {
+					t.Errorf("event %d has ID %d, want %d", i, got[i].ID, id)
+				}
+			}
+		})
+	}
+}
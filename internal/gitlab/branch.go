@@ -0,0 +1,154 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+func (c *Client) ListBranches(projectID string, search string) ([]Branch, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if search != "" {
+		params.Set("search", search)
+	}
+
+	var all []Branch
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/projects/%s/repository/branches?%s", url.PathEscape(projectID), params.Encode())
+
+		var branches []Branch
+		if err := c.get(path, &branches); err != nil {
+			return nil, fmt.Errorf("listing branches: %w", err)
+		}
+
+		all = append(all, branches...)
+		page++
+
+		if len(branches) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func (c *Client) CreateBranch(projectID, name, ref string) (*Branch, error) {
+	path := fmt.Sprintf("/projects/%s/repository/branches", url.PathEscape(projectID))
+
+	body := map[string]interface{}{
+		"branch": name,
+		"ref":    ref,
+	}
+
+	var branch Branch
+	if err := c.post(path, body, &branch); err != nil {
+		return nil, fmt.Errorf("creating branch %s: %w", name, err)
+	}
+
+	return &branch, nil
+}
+
+func (c *Client) DeleteBranch(projectID, name string) error {
+	path := fmt.Sprintf("/projects/%s/repository/branches/%s", url.PathEscape(projectID), url.PathEscape(name))
+
+	if err := c.delete(path); err != nil {
+		return fmt.Errorf("deleting branch %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListProtectedBranches(projectID string) ([]ProtectedBranch, error) {
+	path := fmt.Sprintf("/projects/%s/protected_branches?per_page=100", url.PathEscape(projectID))
+
+	var branches []ProtectedBranch
+	if err := c.get(path, &branches); err != nil {
+		return nil, fmt.Errorf("listing protected branches: %w", err)
+	}
+
+	return branches, nil
+}
+
+// ProtectBranch protects a branch or wildcard pattern such as "release/*".
+func (c *Client) ProtectBranch(projectID string, opts ProtectBranchOptions) (*ProtectedBranch, error) {
+	path := fmt.Sprintf("/projects/%s/protected_branches", url.PathEscape(projectID))
+
+	body := map[string]interface{}{
+		"name":               opts.Name,
+		"push_access_level":  opts.PushAccessLevel,
+		"merge_access_level": opts.MergeAccessLevel,
+	}
+
+	if opts.AllowForcePush {
+		body["allow_force_push"] = true
+	}
+
+	var branch ProtectedBranch
+	if err := c.post(path, body, &branch); err != nil {
+		return nil, fmt.Errorf("protecting branch %s: %w", opts.Name, err)
+	}
+
+	return &branch, nil
+}
+
+func (c *Client) UnprotectBranch(projectID, name string) error {
+	path := fmt.Sprintf("/projects/%s/protected_branches/%s", url.PathEscape(projectID), url.PathEscape(name))
+
+	if err := c.delete(path); err != nil {
+		return fmt.Errorf("unprotecting branch %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListTags(projectID string, search string) ([]Tag, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if search != "" {
+		params.Set("search", search)
+	}
+
+	path := fmt.Sprintf("/projects/%s/repository/tags?%s", url.PathEscape(projectID), params.Encode())
+
+	var tags []Tag
+	if err := c.get(path, &tags); err != nil {
+		return nil, fmt.Errorf("listing tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// CreateTag creates a tag at ref. A non-empty message makes it annotated.
+func (c *Client) CreateTag(projectID, name, ref, message string) (*Tag, error) {
+	path := fmt.Sprintf("/projects/%s/repository/tags", url.PathEscape(projectID))
+
+	body := map[string]interface{}{
+		"tag_name": name,
+		"ref":      ref,
+	}
+
+	if message != "" {
+		body["message"] = message
+	}
+
+	var tag Tag
+	if err := c.post(path, body, &tag); err != nil {
+		return nil, fmt.Errorf("creating tag %s: %w", name, err)
+	}
+
+	return &tag, nil
+}
+
+func (c *Client) DeleteTag(projectID, name string) error {
+	path := fmt.Sprintf("/projects/%s/repository/tags/%s", url.PathEscape(projectID), url.PathEscape(name))
+
+	if err := c.delete(path); err != nil {
+		return fmt.Errorf("deleting tag %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -10,10 +10,24 @@ import (
 	"time"
 )
 
+// APIError is an unsuccessful response of the GitLab API.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
 type Client struct {
 	baseURL    string
 	token      string
 	httpClient *http.Client
+
+	// dryRunOut receives mutating requests instead of the server when
+	// dry-run mode is enabled
+	dryRunOut io.Writer
 }
 
 func NewClient(baseURL, token string) *Client {
@@ -29,7 +43,19 @@ func NewClient(baseURL, token string) *Client {
 	}
 }
 
+// EnableDryRun switches the client to dry-run mode: reads are still sent so
+// that identifiers can be resolved, but every mutating request is written to
+// w (method, path and JSON body) instead and fails with ErrDryRun.
+func (c *Client) EnableDryRun(w io.Writer) {
+	c.dryRunOut = w
+}
+
 func (c *Client) doRequest(method, path string, body io.Reader) (*http.Response, error) {
+	if c.dryRunOut != nil && method != http.MethodGet {
+		writeDryRunRequest(c.dryRunOut, method, path, body)
+		return nil, ErrDryRun
+	}
+
 	url := fmt.Sprintf("%s/api/v4%s", c.baseURL, path)
 
 	req, err := http.NewRequest(method, url, body)
@@ -52,12 +78,33 @@ func (c *Client) get(path string, result interface{}) error {
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	return json.NewDecoder(resp.Body).Decode(result)
 }
 
+// getRaw returns the undecoded response body, for endpoints that serve file
+// contents rather than JSON.
+func (c *Client) getRaw(path string) ([]byte, error) {
+	resp, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	return body, nil
+}
+
 func (c *Client) put(path string, result interface{}) error {
 	resp, err := c.doRequest("PUT", path, nil)
 	if err != nil {
@@ -67,7 +114,7 @@ func (c *Client) put(path string, result interface{}) error {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	if result != nil {
@@ -94,7 +141,7 @@ func (c *Client) post(path string, body interface{}, result interface{}) error {
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	if result != nil {
@@ -121,7 +168,7 @@ func (c *Client) putWithBody(path string, body interface{}, result interface{})
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	if result != nil {
@@ -129,3 +176,18 @@ func (c *Client) putWithBody(path string, body interface{}, result interface{})
 	}
 	return nil
 }
+
+func (c *Client) delete(path string) error {
+	resp, err := c.doRequest("DELETE", path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	return nil
+}
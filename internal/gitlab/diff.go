@@ -0,0 +1,192 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// GetMRDiffs returns the file diffs of an MR's latest version.
+// Falls back to the deprecated /changes endpoint on instances without /diffs,
+// which answer 404.
+func (c *Client) GetMRDiffs(projectID, iid int) ([]MRDiff, error) {
+	var allDiffs []MRDiff
+	page := 1
+
+	for {
+		path := fmt.Sprintf("/projects/%d/merge_requests/%d/diffs?per_page=100&page=%d", projectID, iid, page)
+
+		var diffs []MRDiff
+		if err := c.get(path, &diffs); err != nil {
+			var apiErr *APIError
+			if page == 1 && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				return c.GetMRChanges(projectID, iid)
+			}
+			return nil, fmt.Errorf("getting MR diffs: %w", err)
+		}
+
+		allDiffs = append(allDiffs, diffs...)
+		page++
+
+		if len(diffs) < 100 {
+			break
+		}
+	}
+
+	return allDiffs, nil
+}
+
+func (c *Client) GetMRChanges(projectID, iid int) ([]MRDiff, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/changes", projectID, iid)
+
+	var result struct {
+		Changes []MRDiff `json:"changes"`
+	}
+	if err := c.get(path, &result); err != nil {
+		return nil, fmt.Errorf("getting MR changes: %w", err)
+	}
+
+	return result.Changes, nil
+}
+
+// GetMRVersions returns the MR's diff versions, newest first.
+func (c *Client) GetMRVersions(projectID, iid int) ([]MRVersion, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/versions?per_page=100", projectID, iid)
+
+	var versions []MRVersion
+	if err := c.get(path, &versions); err != nil {
+		return nil, fmt.Errorf("getting MR versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+func (c *Client) GetMRVersion(projectID, iid, versionID int) (*MRVersion, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/versions/%d", projectID, iid, versionID)
+
+	var version MRVersion
+	if err := c.get(path, &version); err != nil {
+		return nil, fmt.Errorf("getting MR version %d: %w", versionID, err)
+	}
+
+	return &version, nil
+}
+
+// CompareRefs diffs two commits directly (straight=true), without going
+// through their merge base.
+func (c *Client) CompareRefs(projectID int, from, to string) (*CompareResult, error) {
+	params := url.Values{}
+	params.Set("from", from)
+	params.Set("to", to)
+	params.Set("straight", "true")
+
+	path := fmt.Sprintf("/projects/%d/repository/compare?%s", projectID, params.Encode())
+
+	var result CompareResult
+	if err := c.get(path, &result); err != nil {
+		return nil, fmt.Errorf("comparing %s..%s: %w", from, to, err)
+	}
+
+	return &result, nil
+}
+
+// CompareBranches lists the commits and diffs on to since it diverged from
+// from, i.e. what a merge request from to into from would contain.
+func (c *Client) CompareBranches(projectID, from, to string) (*CompareResult, error) {
+	params := url.Values{}
+	params.Set("from", from)
+	params.Set("to", to)
+
+	path := fmt.Sprintf("/projects/%s/repository/compare?%s", url.PathEscape(projectID), params.Encode())
+
+	var result CompareResult
+	if err := c.get(path, &result); err != nil {
+		return nil, fmt.Errorf("comparing %s...%s: %w", from, to, err)
+	}
+
+	return &result, nil
+}
+
+// DiffStats counts added and removed lines in a diff body as returned by the
+// API (hunks only, without the ---/+++ file header).
+func DiffStats(diff string) (additions, deletions int) {
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			additions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return additions, deletions
+}
+
+// FilterDiffs keeps diffs whose old or new path matches any include glob
+// (all when none given) and no exclude glob.
+func FilterDiffs(diffs []MRDiff, include, exclude []string) []MRDiff {
+	if len(include) == 0 && len(exclude) == 0 {
+		return diffs
+	}
+
+	matchesAny := func(d MRDiff, patterns []string) bool {
+		for _, p := range patterns {
+			if MatchGlob(p, d.NewPath) || MatchGlob(p, d.OldPath) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var filtered []MRDiff
+	for _, d := range diffs {
+		if len(include) > 0 && !matchesAny(d, include) {
+			continue
+		}
+		if matchesAny(d, exclude) {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+	return filtered
+}
+
+// MatchGlob matches a repository path against a glob pattern. "*" and "?"
+// do not cross directory separators, "**" does. Patterns without a slash
+// match against the base name, like .gitignore.
+func MatchGlob(pattern, filePath string) bool {
+	if filePath == "" {
+		return false
+	}
+	if !strings.Contains(pattern, "/") {
+		filePath = path.Base(filePath)
+	}
+	return globToRegexp(strings.TrimPrefix(pattern, "/")).MatchString(filePath)
+}
+
+func globToRegexp(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		switch {
+		case ch == '*' && strings.HasPrefix(pattern[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case ch == '*' && strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case ch == '*':
+			sb.WriteString("[^/]*")
+		case ch == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
@@ -0,0 +1,106 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*.go", "internal/cli/mr.go", true},
+		{"*.go", "README.md", false},
+		{"internal/*.go", "internal/cli/mr.go", false},
+		{"internal/**/*.go", "internal/cli/mr.go", true},
+		{"internal/**/*.go", "internal/mr.go", true},
+		{"**/testdata/**", "pkg/a/testdata/x.json", true},
+		{"/docs/*", "docs/index.md", true},
+		{"docs/?.md", "docs/a.md", true},
+		{"docs/?.md", "docs/ab.md", false},
+		{"*.go", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			if got := MatchGlob(tt.pattern, tt.path); got != tt.want {
+				t.Errorf("MatchGlob(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterDiffs(t *testing.T) {
+	diffs := []MRDiff{
+		{OldPath: "cmd/main.go", NewPath: "cmd/main.go"},
+		{OldPath: "internal/cli/mr.go", NewPath: "internal/cli/mr.go"},
+		{OldPath: "internal/cli/mr_test.go", NewPath: "internal/cli/mr_test.go"},
+		{OldPath: "old/name.md", NewPath: "docs/name.md", RenamedFile: true},
+	}
+
+	got := FilterDiffs(diffs, []string{"internal/**"}, []string{"*_test.go"})
+	if len(got) != 1 || got[0].NewPath != "internal/cli/mr.go" {
+		t.Errorf("include/exclude filter = %v, want only internal/cli/mr.go", got)
+	}
+
+	got = FilterDiffs(diffs, []string{"old/**"}, nil)
+	if len(got) != 1 || got[0].NewPath != "docs/name.md" {
+		t.Errorf("renamed file should match on old path, got %v", got)
+	}
+
+	if got := FilterDiffs(diffs, nil, nil); len(got) != len(diffs) {
+		t.Errorf("no filters should keep all %d diffs, got %d", len(diffs), len(got))
+	}
+}
+
+func TestDiffStats(t *testing.T) {
+	diff := "@@ -1,3 +1,3 @@\n context\n-old line\n--- removed sql comment\n+new line\n"
+
+	additions, deletions := DiffStats(diff)
+	if additions != 1 || deletions != 2 {
+		t.Errorf("DiffStats() = +%d -%d, want +1 -2", additions, deletions)
+	}
+}
+
+func TestGetMRDiffsFallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		diffsStatus int
+		wantChanges bool
+		wantErr     bool
+	}{
+		{"diffs endpoint missing", http.StatusNotFound, true, false},
+		{"forbidden", http.StatusForbidden, false, true},
+		{"server error", http.StatusInternalServerError, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changesCalled := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v4/projects/5/merge_requests/12/diffs":
+					w.WriteHeader(tt.diffsStatus)
+					w.Write([]byte(`{"message":"error"}`))
+				case "/api/v4/projects/5/merge_requests/12/changes":
+					changesCalled = true
+					w.Write([]byte(`{"changes":[{"new_path":"a.go"}]}`))
+				}
+			}))
+			defer srv.Close()
+
+			diffs, err := NewClient(srv.URL, "token").GetMRDiffs(5, 12)
+			if changesCalled != tt.wantChanges {
+				t.Errorf("/changes called = %v, want %v", changesCalled, tt.wantChanges)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (len(diffs) != 1 || diffs[0].NewPath != "a.go") {
+				t.Errorf("diffs = %+v", diffs)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrDryRun is returned for mutating requests of a client in dry-run mode.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+func writeDryRunRequest(w io.Writer, method, path string, body io.Reader) {
+	fmt.Fprintf(w, "Dry run: %s %s\n", method, path)
+	if body == nil {
+		return
+	}
+
+	raw, err := io.ReadAll(body)
+	if err != nil || len(raw) == 0 {
+		return
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, raw, "", "  "); err != nil {
+		fmt.Fprintf(w, "%s\n", raw)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", pretty.String())
+}
+
+// PreviewMRUpdate returns the fields of mr that an update with opts would
+// change. User IDs are shown by username when the MR already references the
+// user, and as "#ID" otherwise.
+func PreviewMRUpdate(mr *MergeRequest, opts UpdateMROptions) []FieldChange {
+	var changes []FieldChange
+	add := func(field, before, after string) {
+		if before != after {
+			changes = append(changes, FieldChange{Field: field, Before: before, After: after})
+		}
+	}
+
+	if opts.Title != nil {
+		add("title", mr.Title, *opts.Title)
+	}
+	if opts.Description != nil {
+		add("description", mr.Description, *opts.Description)
+	}
+	if opts.TargetBranch != nil {
+		add("target_branch", mr.TargetBranch, *opts.TargetBranch)
+	}
+	if opts.Labels != nil {
+		var after []string
+		for _, l := range strings.Split(*opts.Labels, ",") {
+			if l = strings.TrimSpace(l); l != "" {
+				after = append(after, l)
+			}
+		}
+		add("labels", strings.Join(mr.Labels, ", "), strings.Join(after, ", "))
+	}
+
+	names := make(map[int]string)
+	for _, u := range append(append([]User(nil), mr.Assignees...), mr.Reviewers...) {
+		names[u.ID] = u.Username
+	}
+	if opts.AssigneeIDs != nil {
+		add("assignees", formatUsers(mr.Assignees), formatUserIDs(opts.AssigneeIDs, names))
+	}
+	if opts.ReviewerIDs != nil {
+		add("reviewers", formatUsers(mr.Reviewers), formatUserIDs(opts.ReviewerIDs, names))
+	}
+
+	if opts.MilestoneID != nil {
+		before, after := "", ""
+		if mr.Milestone != nil {
+			before = mr.Milestone.Title
+		}
+		switch {
+		case *opts.MilestoneID == 0:
+		case mr.Milestone != nil && mr.Milestone.ID == *opts.MilestoneID:
+			after = mr.Milestone.Title
+		default:
+			after = "#" + strconv.Itoa(*opts.MilestoneID)
+		}
+		add("milestone", before, after)
+	}
+
+	if opts.StateEvent != nil {
+		after := mr.State
+		switch *opts.StateEvent {
+		case "close":
+			after = "closed"
+		case "reopen":
+			after = "opened"
+		}
+		add("state", mr.State, after)
+	}
+
+	boolField := func(field string, before bool, after *bool) {
+		if after != nil {
+			add(field, strconv.FormatBool(before), strconv.FormatBool(*after))
+		}
+	}
+	boolField("draft", mr.Draft, opts.Draft)
+	boolField("remove_source_branch", mr.ForceRemoveSource, opts.RemoveSourceBranch)
+	boolField("squash", mr.Squash, opts.Squash)
+	boolField("discussion_locked", mr.DiscussionLocked, opts.DiscussionLocked)
+	boolField("allow_collaboration", mr.AllowCollaboration, opts.AllowCollaboration)
+
+	return changes
+}
+
+func formatUsers(users []User) string {
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Username
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatUserIDs(ids []int, names map[int]string) string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		if name, ok := names[id]; ok {
+			out[i] = name
+		} else {
+			out[i] = "#" + strconv.Itoa(id)
+		}
+	}
+	return strings.Join(out, ", ")
+}
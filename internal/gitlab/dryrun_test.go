@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDryRunBlocksWrites(t *testing.T) {
+	var out bytes.Buffer
+	client := NewClient("http://gitlab.invalid", "test-token")
+	client.EnableDryRun(&out)
+
+	_, err := client.UpdateMRLabels(1, 2, []string{"bug", "urgent"})
+	if !errors.Is(err, ErrDryRun) {
+		t.Fatalf("expected ErrDryRun, got %v", err)
+	}
+
+	got := out.String()
+	for _, want := range []string{"Dry run: PUT /projects/1/merge_requests/2", `"labels": "bug,urgent"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestPreviewMRUpdate(t *testing.T) {
+	title := "New title"
+	labels := "bug, urgent"
+	draft := false
+	closeEvent := "close"
+
+	mr := &MergeRequest{
+		Title:     "Old title",
+		State:     "opened",
+		Labels:    []string{"bug"},
+		Reviewers: []User{{ID: 1, Username: "alice"}},
+		Milestone: &Milestone{ID: 4, Title: "v1.0"},
+	}
+
+	tests := []struct {
+		name string
+		opts UpdateMROptions
+		want []FieldChange
+	}{
+		{"no options", UpdateMROptions{}, nil},
+		{"unchanged value", UpdateMROptions{Draft: &draft}, nil},
+		{
+			"title and labels",
+			UpdateMROptions{Title: &title, Labels: &labels},
+			[]FieldChange{
+				{Field: "title", Before: "Old title", After: "New title"},
+				{Field: "labels", Before: "bug", After: "bug, urgent"},
+			},
+		},
+		{
+			"reviewers by username or id",
+			UpdateMROptions{ReviewerIDs: []int{1, 9}},
+			[]FieldChange{{Field: "reviewers", Before: "alice", After: "alice, #9"}},
+		},
+		{
+			"unassign milestone",
+			UpdateMROptions{MilestoneID: new(int)},
+			[]FieldChange{{Field: "milestone", Before: "v1.0", After: ""}},
+		},
+		{
+			"close",
+			UpdateMROptions{StateEvent: &closeEvent},
+			[]FieldChange{{Field: "state", Before: "opened", After: "closed"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PreviewMRUpdate(mr, tt.opts)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("change %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
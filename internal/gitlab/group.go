@@ -0,0 +1,165 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+func (c *Client) ListGroups(opts ListGroupsOptions) ([]Group, error) {
+	params := url.Values{}
+
+	if opts.PerPage > 0 {
+		params.Set("per_page", strconv.Itoa(opts.PerPage))
+	} else {
+		params.Set("per_page", "20")
+	}
+
+	if opts.Search != "" {
+		params.Set("search", opts.Search)
+	}
+
+	if opts.Owned {
+		params.Set("owned", "true")
+	}
+
+	path := "/groups?" + params.Encode()
+	if opts.ParentID != "" {
+		// Direct subgroups, or every group below the parent when recursive
+		endpoint := "subgroups"
+		if opts.Recursive {
+			endpoint = "descendant_groups"
+		}
+		path = fmt.Sprintf("/groups/%s/%s?%s", url.PathEscape(opts.ParentID), endpoint, params.Encode())
+	}
+
+	var groups []Group
+	if err := c.get(path, &groups); err != nil {
+		return nil, fmt.Errorf("listing groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func (c *Client) GetGroup(groupID string) (*Group, error) {
+	path := fmt.Sprintf("/groups/%s?with_projects=false", url.PathEscape(groupID))
+
+	var group Group
+	if err := c.get(path, &group); err != nil {
+		return nil, fmt.Errorf("getting group %s: %w", groupID, err)
+	}
+
+	return &group, nil
+}
+
+// ListDescendantGroups returns every group below the given group.
+func (c *Client) ListDescendantGroups(groupID string) ([]Group, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+
+	var all []Group
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/groups/%s/descendant_groups?%s", url.PathEscape(groupID), params.Encode())
+
+		var groups []Group
+		if err := c.get(path, &groups); err != nil {
+			return nil, fmt.Errorf("listing subgroups: %w", err)
+		}
+
+		all = append(all, groups...)
+		page++
+
+		if len(groups) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// ListGroupProjects returns all projects of a group, optionally including
+// projects of its subgroups.
+func (c *Client) ListGroupProjects(groupID string, includeSubgroups bool) ([]Project, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if includeSubgroups {
+		params.Set("include_subgroups", "true")
+	}
+
+	var all []Project
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/groups/%s/projects?%s", url.PathEscape(groupID), params.Encode())
+
+		var projects []Project
+		if err := c.get(path, &projects); err != nil {
+			return nil, fmt.Errorf("listing group projects: %w", err)
+		}
+
+		all = append(all, projects...)
+		page++
+
+		if len(projects) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// ListGroupMRs returns every MR in the group and its subgroups in the given
+// state, following pagination.
+func (c *Client) ListGroupMRs(groupID string, state string) ([]MergeRequest, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	var all []MergeRequest
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/groups/%s/merge_requests?%s", url.PathEscape(groupID), params.Encode())
+
+		var mrs []MergeRequest
+		if err := c.get(path, &mrs); err != nil {
+			return nil, fmt.Errorf("listing group MRs: %w", err)
+		}
+
+		all = append(all, mrs...)
+		page++
+
+		if len(mrs) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// ListGroupMembers returns the members of a group, including members
+// inherited from ancestor groups.
+func (c *Client) ListGroupMembers(groupID string, search string) ([]User, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+
+	if search != "" {
+		params.Set("query", search)
+	}
+
+	path := fmt.Sprintf("/groups/%s/members/all?%s", url.PathEscape(groupID), params.Encode())
+
+	var members []User
+	if err := c.get(path, &members); err != nil {
+		return nil, fmt.Errorf("listing group members: %w", err)
+	}
+
+	return members, nil
+}
@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func (c *Client) ListProjectHooks(projectID string) ([]ProjectHook, error) {
+	path := fmt.Sprintf("/projects/%s/hooks?per_page=100", url.PathEscape(projectID))
+
+	var hooks []ProjectHook
+	if err := c.get(path, &hooks); err != nil {
+		return nil, fmt.Errorf("listing hooks: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func (c *Client) CreateProjectHook(projectID string, opts ProjectHookOptions) (*ProjectHook, error) {
+	path := fmt.Sprintf("/projects/%s/hooks", url.PathEscape(projectID))
+
+	var hook ProjectHook
+	if err := c.post(path, opts.requestBody(), &hook); err != nil {
+		return nil, fmt.Errorf("creating hook: %w", err)
+	}
+
+	return &hook, nil
+}
+
+func (c *Client) UpdateProjectHook(projectID string, hookID int, opts ProjectHookOptions) (*ProjectHook, error) {
+	path := fmt.Sprintf("/projects/%s/hooks/%d", url.PathEscape(projectID), hookID)
+
+	var hook ProjectHook
+	if err := c.putWithBody(path, opts.requestBody(), &hook); err != nil {
+		return nil, fmt.Errorf("updating hook: %w", err)
+	}
+
+	return &hook, nil
+}
+
+func (opts ProjectHookOptions) requestBody() map[string]interface{} {
+	body := map[string]interface{}{
+		"url":                     opts.URL,
+		"push_events":             opts.PushEvents,
+		"merge_requests_events":   opts.MergeRequestsEvents,
+		"pipeline_events":         opts.PipelineEvents,
+		"note_events":             opts.NoteEvents,
+		"enable_ssl_verification": opts.EnableSSLVerification,
+	}
+
+	if opts.Token != "" {
+		body["token"] = opts.Token
+	}
+
+	return body
+}
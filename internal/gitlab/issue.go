@@ -0,0 +1,198 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListIssues lists issues of a single project, or across all projects
+// visible to the user when opts.ProjectID is empty.
+func (c *Client) ListIssues(opts ListIssuesOptions) ([]Issue, error) {
+	params := url.Values{}
+
+	if opts.State != "" {
+		params.Set("state", opts.State)
+	} else {
+		params.Set("state", "opened")
+	}
+
+	if opts.Scope != "" {
+		params.Set("scope", opts.Scope)
+	} else {
+		params.Set("scope", "all")
+	}
+
+	if opts.Labels != "" {
+		params.Set("labels", opts.Labels)
+	}
+
+	if opts.Search != "" {
+		params.Set("search", opts.Search)
+	}
+
+	if opts.PerPage > 0 {
+		params.Set("per_page", strconv.Itoa(opts.PerPage))
+	} else {
+		params.Set("per_page", "20")
+	}
+
+	path := "/issues?" + params.Encode()
+	if opts.ProjectID != "" {
+		path = fmt.Sprintf("/projects/%s/issues?%s", url.PathEscape(opts.ProjectID), params.Encode())
+	}
+
+	var issues []Issue
+	if err := c.get(path, &issues); err != nil {
+		return nil, fmt.Errorf("listing issues: %w", err)
+	}
+
+	return issues, nil
+}
+
+func (c *Client) GetIssue(projectID string, iid int) (*Issue, error) {
+	path := fmt.Sprintf("/projects/%s/issues/%d", url.PathEscape(projectID), iid)
+
+	var issue Issue
+	if err := c.get(path, &issue); err != nil {
+		return nil, fmt.Errorf("getting issue: %w", err)
+	}
+
+	return &issue, nil
+}
+
+func (c *Client) CreateIssue(projectID string, opts CreateIssueOptions) (*Issue, error) {
+	path := fmt.Sprintf("/projects/%s/issues", url.PathEscape(projectID))
+
+	body := opts.RequestBody()
+
+	var issue Issue
+	if err := c.post(path, body, &issue); err != nil {
+		return nil, fmt.Errorf("creating issue: %w", err)
+	}
+
+	return &issue, nil
+}
+
+func (c *Client) UpdateIssue(projectID string, iid int, opts UpdateIssueOptions) (*Issue, error) {
+	path := fmt.Sprintf("/projects/%s/issues/%d", url.PathEscape(projectID), iid)
+
+	body := opts.RequestBody()
+
+	if len(body) == 0 {
+		return nil, fmt.Errorf("updating issue: no fields to update")
+	}
+
+	var issue Issue
+	if err := c.putWithBody(path, body, &issue); err != nil {
+		return nil, fmt.Errorf("updating issue: %w", err)
+	}
+
+	return &issue, nil
+}
+
+func (c *Client) CreateIssueNote(projectID string, iid int, body string) (*Note, error) {
+	path := fmt.Sprintf("/projects/%s/issues/%d/notes", url.PathEscape(projectID), iid)
+
+	var note Note
+	if err := c.post(path, map[string]interface{}{"body": body}, &note); err != nil {
+		return nil, fmt.Errorf("commenting on issue: %w", err)
+	}
+
+	return &note, nil
+}
+
+// GetIssueRelatedMRs returns MRs that mention the issue or will close it.
+func (c *Client) GetIssueRelatedMRs(projectID string, iid int) ([]MergeRequest, error) {
+	path := fmt.Sprintf("/projects/%s/issues/%d/related_merge_requests?per_page=100", url.PathEscape(projectID), iid)
+
+	var mrs []MergeRequest
+	if err := c.get(path, &mrs); err != nil {
+		return nil, fmt.Errorf("getting related MRs: %w", err)
+	}
+
+	return mrs, nil
+}
+
+// GetIssueClosedBy returns MRs that will close the issue when merged.
+func (c *Client) GetIssueClosedBy(projectID string, iid int) ([]MergeRequest, error) {
+	path := fmt.Sprintf("/projects/%s/issues/%d/closed_by?per_page=100", url.PathEscape(projectID), iid)
+
+	var mrs []MergeRequest
+	if err := c.get(path, &mrs); err != nil {
+		return nil, fmt.Errorf("getting closing MRs: %w", err)
+	}
+
+	return mrs, nil
+}
+
+// GetMRClosesIssues returns the issues the MR will close when merged,
+// based on closing patterns such as "Closes #N" in its description.
+func (c *Client) GetMRClosesIssues(projectID, iid int) ([]Issue, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/closes_issues?per_page=100", projectID, iid)
+
+	var issues []Issue
+	if err := c.get(path, &issues); err != nil {
+		return nil, fmt.Errorf("getting MR closing issues: %w", err)
+	}
+
+	return issues, nil
+}
+
+// RequestBody returns the JSON body CreateIssue sends for these options.
+func (opts CreateIssueOptions) RequestBody() map[string]interface{} {
+	body := map[string]interface{}{
+		"title": opts.Title,
+	}
+
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+
+	if len(opts.Labels) > 0 {
+		body["labels"] = strings.Join(opts.Labels, ",")
+	}
+
+	if len(opts.AssigneeIDs) > 0 {
+		body["assignee_ids"] = opts.AssigneeIDs
+	}
+
+	if opts.DueDate != "" {
+		body["due_date"] = opts.DueDate
+	}
+
+	return body
+}
+
+// RequestBody returns the JSON body UpdateIssue sends for these options.
+// Only fields that are set are included.
+func (opts UpdateIssueOptions) RequestBody() map[string]interface{} {
+	body := make(map[string]interface{})
+	if opts.Title != nil {
+		body["title"] = *opts.Title
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+	if opts.Labels != nil {
+		body["labels"] = *opts.Labels
+	}
+	if len(opts.AddLabels) > 0 {
+		body["add_labels"] = strings.Join(opts.AddLabels, ",")
+	}
+	if len(opts.RemoveLabels) > 0 {
+		body["remove_labels"] = strings.Join(opts.RemoveLabels, ",")
+	}
+	if opts.AssigneeIDs != nil {
+		body["assignee_ids"] = opts.AssigneeIDs
+	}
+	if opts.DueDate != nil {
+		body["due_date"] = *opts.DueDate
+	}
+	if opts.StateEvent != nil {
+		body["state_event"] = *opts.StateEvent
+	}
+
+	return body
+}
@@ -0,0 +1,95 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// iterationPath returns the iterations collection path of a project or group.
+func iterationPath(projectID, groupID string) (string, error) {
+	switch {
+	case projectID != "" && groupID != "":
+		return "", fmt.Errorf("iterations: specify a project or a group, not both")
+	case groupID != "":
+		return fmt.Sprintf("/groups/%s/iterations", url.PathEscape(groupID)), nil
+	case projectID != "":
+		return fmt.Sprintf("/projects/%s/iterations", url.PathEscape(projectID)), nil
+	}
+	return "", fmt.Errorf("iterations: a project or group is required")
+}
+
+func (c *Client) ListIterations(opts ListIterationsOptions) ([]Iteration, error) {
+	base, err := iterationPath(opts.ProjectID, opts.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("per_page", "100")
+
+	if opts.State != "" {
+		params.Set("state", opts.State)
+	}
+	if opts.Search != "" {
+		params.Set("search", opts.Search)
+	}
+	// Iterations belong to groups; include those of every ancestor
+	params.Set("include_ancestors", "true")
+
+	var all []Iteration
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+
+		var iterations []Iteration
+		if err := c.get(base+"?"+params.Encode(), &iterations); err != nil {
+			return nil, fmt.Errorf("listing iterations: %w", err)
+		}
+
+		all = append(all, iterations...)
+		page++
+
+		if len(iterations) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// GetIterationIssues lists the issues of an iteration, limited to a project
+// or group.
+func (c *Client) GetIterationIssues(projectID, groupID string, id int) ([]Issue, error) {
+	var base string
+	switch {
+	case groupID != "":
+		base = fmt.Sprintf("/groups/%s/issues", url.PathEscape(groupID))
+	case projectID != "":
+		base = fmt.Sprintf("/projects/%s/issues", url.PathEscape(projectID))
+	default:
+		return nil, fmt.Errorf("iteration issues: a project or group is required")
+	}
+
+	var all []Issue
+	page := 1
+
+	for {
+		path := fmt.Sprintf("%s?iteration_id=%d&state=all&scope=all&per_page=100&page=%d", base, id, page)
+
+		var issues []Issue
+		if err := c.get(path, &issues); err != nil {
+			return nil, fmt.Errorf("getting iteration issues: %w", err)
+		}
+
+		all = append(all, issues...)
+		page++
+
+		if len(issues) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
@@ -3,8 +3,22 @@ package gitlab
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
+// labelPath returns the labels collection path of a project or group.
+func labelPath(projectID, groupID string) (string, error) {
+	switch {
+	case projectID != "" && groupID != "":
+		return "", fmt.Errorf("labels: specify a project or a group, not both")
+	case groupID != "":
+		return fmt.Sprintf("/groups/%s/labels", url.PathEscape(groupID)), nil
+	case projectID != "":
+		return fmt.Sprintf("/projects/%s/labels", url.PathEscape(projectID)), nil
+	}
+	return "", fmt.Errorf("labels: a project or group is required")
+}
+
 func (c *Client) ListProjectLabels(projectID string, search string) ([]Label, error) {
 	encoded := url.PathEscape(projectID)
 	params := url.Values{}
@@ -23,3 +37,125 @@ func (c *Client) ListProjectLabels(projectID string, search string) ([]Label, er
 
 	return labels, nil
 }
+
+func (c *Client) ListGroupLabels(groupID string, search string, includeSubgroups bool) ([]Label, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+
+	if search != "" {
+		params.Set("search", search)
+	}
+
+	if includeSubgroups {
+		params.Set("include_descendant_groups", "true")
+	}
+
+	path := fmt.Sprintf("/groups/%s/labels?%s", url.PathEscape(groupID), params.Encode())
+
+	var labels []Label
+	if err := c.get(path, &labels); err != nil {
+		return nil, fmt.Errorf("listing group labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+// ListOwnLabels returns every label defined directly on a project or group,
+// leaving out labels inherited from ancestor groups.
+func (c *Client) ListOwnLabels(projectID, groupID string) ([]Label, error) {
+	base, err := labelPath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("per_page", "100")
+	params.Set("include_ancestor_groups", "false")
+
+	var all []Label
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+
+		var labels []Label
+		if err := c.get(base+"?"+params.Encode(), &labels); err != nil {
+			return nil, fmt.Errorf("listing labels: %w", err)
+		}
+
+		all = append(all, labels...)
+		page++
+
+		if len(labels) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func (c *Client) CreateLabel(projectID, groupID string, opts CreateLabelOptions) (*Label, error) {
+	base, err := labelPath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":  opts.Name,
+		"color": opts.Color,
+	}
+
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+
+	var label Label
+	if err := c.post(base, body, &label); err != nil {
+		return nil, fmt.Errorf("creating label: %w", err)
+	}
+
+	return &label, nil
+}
+
+func (c *Client) UpdateLabel(projectID, groupID, name string, opts UpdateLabelOptions) (*Label, error) {
+	base, err := labelPath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+
+	if opts.NewName != "" {
+		body["new_name"] = opts.NewName
+	}
+	if opts.Color != "" {
+		body["color"] = opts.Color
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+
+	if len(body) == 0 {
+		return nil, fmt.Errorf("updating label: no fields to update")
+	}
+
+	var label Label
+	if err := c.putWithBody(base+"/"+url.PathEscape(name), body, &label); err != nil {
+		return nil, fmt.Errorf("updating label: %w", err)
+	}
+
+	return &label, nil
+}
+
+func (c *Client) DeleteLabel(projectID, groupID, name string) error {
+	base, err := labelPath(projectID, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.delete(base + "/" + url.PathEscape(name)); err != nil {
+		return fmt.Errorf("deleting label: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// milestonePath returns the milestones collection path of a project or group.
+func milestonePath(projectID, groupID string) (string, error) {
+	switch {
+	case projectID != "" && groupID != "":
+		return "", fmt.Errorf("milestones: specify a project or a group, not both")
+	case groupID != "":
+		return fmt.Sprintf("/groups/%s/milestones", url.PathEscape(groupID)), nil
+	case projectID != "":
+		return fmt.Sprintf("/projects/%s/milestones", url.PathEscape(projectID)), nil
+	}
+	return "", fmt.Errorf("milestones: a project or group is required")
+}
+
+func (c *Client) ListMilestones(opts ListMilestonesOptions) ([]Milestone, error) {
+	base, err := milestonePath(opts.ProjectID, opts.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("per_page", "100")
+
+	if opts.State != "" && opts.State != "all" {
+		params.Set("state", opts.State)
+	}
+	if opts.Title != "" {
+		params.Set("title", opts.Title)
+	}
+	if opts.Search != "" {
+		params.Set("search", opts.Search)
+	}
+	if opts.ProjectID != "" {
+		// Project milestones can be group milestones inherited from ancestors
+		params.Set("include_ancestors", "true")
+	}
+
+	var milestones []Milestone
+	if err := c.get(base+"?"+params.Encode(), &milestones); err != nil {
+		return nil, fmt.Errorf("listing milestones: %w", err)
+	}
+
+	return milestones, nil
+}
+
+func (c *Client) GetMilestone(projectID, groupID string, id int) (*Milestone, error) {
+	base, err := milestonePath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var milestone Milestone
+	if err := c.get(fmt.Sprintf("%s/%d", base, id), &milestone); err != nil {
+		return nil, fmt.Errorf("getting milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+func (c *Client) CreateMilestone(projectID, groupID string, opts CreateMilestoneOptions) (*Milestone, error) {
+	base, err := milestonePath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"title": opts.Title,
+	}
+
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+	if opts.StartDate != "" {
+		body["start_date"] = opts.StartDate
+	}
+	if opts.DueDate != "" {
+		body["due_date"] = opts.DueDate
+	}
+
+	var milestone Milestone
+	if err := c.post(base, body, &milestone); err != nil {
+		return nil, fmt.Errorf("creating milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// UpdateMilestoneState closes or reactivates a milestone. stateEvent is
+// "close" or "activate".
+func (c *Client) UpdateMilestoneState(projectID, groupID string, id int, stateEvent string) (*Milestone, error) {
+	base, err := milestonePath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"state_event": stateEvent,
+	}
+
+	var milestone Milestone
+	if err := c.putWithBody(fmt.Sprintf("%s/%d", base, id), body, &milestone); err != nil {
+		return nil, fmt.Errorf("updating milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+func (c *Client) GetMilestoneMRs(projectID, groupID string, id int) ([]MergeRequest, error) {
+	base, err := milestonePath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []MergeRequest
+	page := 1
+
+	for {
+		path := fmt.Sprintf("%s/%d/merge_requests?per_page=100&page=%d", base, id, page)
+
+		var mrs []MergeRequest
+		if err := c.get(path, &mrs); err != nil {
+			return nil, fmt.Errorf("getting milestone MRs: %w", err)
+		}
+
+		all = append(all, mrs...)
+		page++
+
+		if len(mrs) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func (c *Client) GetMilestoneIssues(projectID, groupID string, id int) ([]Issue, error) {
+	base, err := milestonePath(projectID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []Issue
+	page := 1
+
+	for {
+		path := fmt.Sprintf("%s/%d/issues?per_page=100&page=%d", base, id, page)
+
+		var issues []Issue
+		if err := c.get(path, &issues); err != nil {
+			return nil, fmt.Errorf("getting milestone issues: %w", err)
+		}
+
+		all = append(all, issues...)
+		page++
+
+		if len(issues) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// ResolveMilestoneID takes a milestone title or numeric ID and returns the
+// milestone ID usable on the project's MRs and issues. Group milestones
+// inherited by the project are included in the title lookup.
+func (c *Client) ResolveMilestoneID(projectID string, milestoneRef string) (int, error) {
+	// Try to parse as integer first
+	if id, err := strconv.Atoi(milestoneRef); err == nil {
+		return id, nil
+	}
+
+	milestones, err := c.ListMilestones(ListMilestonesOptions{
+		ProjectID: projectID,
+		Title:     milestoneRef,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// A closed and an active milestone may share a title; prefer the active one
+	found := 0
+	for _, m := range milestones {
+		if m.Title != milestoneRef {
+			continue
+		}
+		if m.State == "active" {
+			return m.ID, nil
+		}
+		if found == 0 {
+			found = m.ID
+		}
+	}
+	if found != 0 {
+		return found, nil
+	}
+
+	return 0, fmt.Errorf("milestone '%s' not found", milestoneRef)
+}
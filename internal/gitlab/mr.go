@@ -8,6 +8,37 @@ import (
 )
 
 func (c *Client) ListMRs(opts ListMROptions) ([]MergeRequest, error) {
+	var mrs []MergeRequest
+	if err := c.get(mrListPath(opts), &mrs); err != nil {
+		return nil, fmt.Errorf("listing MRs: %w", err)
+	}
+
+	return mrs, nil
+}
+
+// ListAllMRs returns every MR matching opts, following pagination;
+// opts.PerPage is ignored.
+func (c *Client) ListAllMRs(opts ListMROptions) ([]MergeRequest, error) {
+	opts.PerPage = 100
+
+	var all []MergeRequest
+	for page := 1; ; page++ {
+		var mrs []MergeRequest
+		if err := c.get(mrListPath(opts)+"&page="+strconv.Itoa(page), &mrs); err != nil {
+			return nil, fmt.Errorf("listing MRs: %w", err)
+		}
+
+		all = append(all, mrs...)
+
+		if len(mrs) < opts.PerPage {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func mrListPath(opts ListMROptions) string {
 	params := url.Values{}
 
 	if opts.State != "" {
@@ -26,6 +57,10 @@ func (c *Client) ListMRs(opts ListMROptions) ([]MergeRequest, error) {
 		params.Set("project_id", strconv.Itoa(opts.ProjectID))
 	}
 
+	if opts.ReviewerID > 0 {
+		params.Set("reviewer_id", strconv.Itoa(opts.ReviewerID))
+	}
+
 	if opts.PerPage > 0 {
 		params.Set("per_page", strconv.Itoa(opts.PerPage))
 	} else {
@@ -36,14 +71,46 @@ func (c *Client) ListMRs(opts ListMROptions) ([]MergeRequest, error) {
 		params.Set("approved_by_ids", opts.ApprovedByIDs)
 	}
 
-	path := "/merge_requests?" + params.Encode()
+	if opts.UpdatedAfter != "" {
+		params.Set("updated_after", opts.UpdatedAfter)
+	}
 
-	var mrs []MergeRequest
-	if err := c.get(path, &mrs); err != nil {
-		return nil, fmt.Errorf("listing MRs: %w", err)
+	if opts.GroupID != "" {
+		return fmt.Sprintf("/groups/%s/merge_requests?%s", url.PathEscape(opts.GroupID), params.Encode())
 	}
+	return "/merge_requests?" + params.Encode()
+}
 
-	return mrs, nil
+// ListProjectMRs returns every MR of the project in the given state,
+// following pagination.
+func (c *Client) ListProjectMRs(projectID string, state string) ([]MergeRequest, error) {
+	params := url.Values{}
+	params.Set("per_page", "100")
+	if state != "" {
+		params.Set("state", state)
+	}
+
+	var all []MergeRequest
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/projects/%s/merge_requests?%s", url.PathEscape(projectID), params.Encode())
+
+		var mrs []MergeRequest
+		if err := c.get(path, &mrs); err != nil {
+			return nil, fmt.Errorf("listing project MRs: %w", err)
+		}
+
+		all = append(all, mrs...)
+		page++
+
+		if len(mrs) < 100 {
+			break
+		}
+	}
+
+	return all, nil
 }
 
 func (c *Client) GetMR(projectID, iid int) (*MergeRequest, error) {
@@ -57,6 +124,21 @@ func (c *Client) GetMR(projectID, iid int) (*MergeRequest, error) {
 	return &mr, nil
 }
 
+// GetMRDivergedCommits returns how many commits the target branch has that
+// the MR's source branch lacks.
+func (c *Client) GetMRDivergedCommits(projectID, iid int) (int, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d?include_diverged_commits_count=true", projectID, iid)
+
+	var mr struct {
+		DivergedCommitsCount int `json:"diverged_commits_count"`
+	}
+	if err := c.get(path, &mr); err != nil {
+		return 0, fmt.Errorf("getting MR diverged commits: %w", err)
+	}
+
+	return mr.DivergedCommitsCount, nil
+}
+
 func (c *Client) GetMRByGlobalID(id int) (*MergeRequest, error) {
 	// GitLab's global MR endpoint may not be available on all instances
 	// So we search through all MRs to find the one with matching ID
@@ -143,31 +225,7 @@ func (c *Client) CreateMR(projectID string, opts CreateMROptions) (*MergeRequest
 	encoded := url.PathEscape(projectID)
 	path := fmt.Sprintf("/projects/%s/merge_requests", encoded)
 
-	body := map[string]interface{}{
-		"source_branch": opts.SourceBranch,
-		"target_branch": opts.TargetBranch,
-		"title":         opts.Title,
-	}
-
-	if opts.Description != "" {
-		body["description"] = opts.Description
-	}
-
-	if opts.Draft {
-		body["draft"] = true
-	}
-
-	if opts.Squash {
-		body["squash"] = true
-	}
-
-	if opts.RemoveSourceBranch {
-		body["remove_source_branch"] = true
-	}
-
-	if opts.AllowCollaboration {
-		body["allow_collaboration"] = true
-	}
+	body := opts.RequestBody()
 
 	var mr MergeRequest
 	if err := c.post(path, body, &mr); err != nil {
@@ -222,46 +280,7 @@ func (c *Client) UpdateMRAssignees(projectID, iid int, assigneeIDs []int) (*Merg
 func (c *Client) UpdateMR(projectID, iid int, opts UpdateMROptions) (*MergeRequest, error) {
 	path := fmt.Sprintf("/projects/%d/merge_requests/%d", projectID, iid)
 
-	body := make(map[string]interface{})
-	if opts.Title != nil {
-		body["title"] = *opts.Title
-	}
-	if opts.Description != nil {
-		body["description"] = *opts.Description
-	}
-	if opts.TargetBranch != nil {
-		body["target_branch"] = *opts.TargetBranch
-	}
-	if opts.AssigneeIDs != nil {
-		body["assignee_ids"] = opts.AssigneeIDs
-	}
-	if opts.ReviewerIDs != nil {
-		body["reviewer_ids"] = opts.ReviewerIDs
-	}
-	if opts.Labels != nil {
-		body["labels"] = *opts.Labels
-	}
-	if opts.MilestoneID != nil {
-		body["milestone_id"] = *opts.MilestoneID
-	}
-	if opts.StateEvent != nil {
-		body["state_event"] = *opts.StateEvent
-	}
-	if opts.Draft != nil {
-		body["draft"] = *opts.Draft
-	}
-	if opts.RemoveSourceBranch != nil {
-		body["remove_source_branch"] = *opts.RemoveSourceBranch
-	}
-	if opts.Squash != nil {
-		body["squash"] = *opts.Squash
-	}
-	if opts.DiscussionLocked != nil {
-		body["discussion_locked"] = *opts.DiscussionLocked
-	}
-	if opts.AllowCollaboration != nil {
-		body["allow_collaboration"] = *opts.AllowCollaboration
-	}
+	body := opts.RequestBody()
 
 	if len(body) == 0 {
 		return nil, fmt.Errorf("updating MR: no fields to update")
@@ -310,6 +329,17 @@ func (c *Client) GetMRDiscussions(projectID, iid int) ([]Discussion, error) {
 	return discussions, nil
 }
 
+func (c *Client) CreateMRNote(projectID, iid int, body string) (*Note, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/notes", projectID, iid)
+
+	var note Note
+	if err := c.post(path, map[string]interface{}{"body": body}, &note); err != nil {
+		return nil, fmt.Errorf("commenting on MR: %w", err)
+	}
+
+	return &note, nil
+}
+
 func (c *Client) GetMRApprovals(projectID, iid int) (*ApprovalState, error) {
 	path := fmt.Sprintf("/projects/%d/merge_requests/%d/approvals", projectID, iid)
 
@@ -321,6 +351,55 @@ func (c *Client) GetMRApprovals(projectID, iid int) (*ApprovalState, error) {
 	return &approvals, nil
 }
 
+// GetMRApprovalState returns the approval summary together with the
+// per-rule breakdown from the approval_state endpoint.
+func (c *Client) GetMRApprovalState(projectID, iid int) (*ApprovalState, error) {
+	approvals, err := c.GetMRApprovals(projectID, iid)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/approval_state", projectID, iid)
+
+	var state ApprovalState
+	if err := c.get(path, &state); err != nil {
+		return nil, fmt.Errorf("getting MR approval rules: %w", err)
+	}
+
+	approvals.RulesOverwritten = state.RulesOverwritten
+	approvals.Rules = state.Rules
+
+	return approvals, nil
+}
+
+// ApproveMR approves the MR as the current user. When sha is set, GitLab
+// rejects the approval if the MR head no longer matches it.
+func (c *Client) ApproveMR(projectID, iid int, sha string) (*ApprovalState, error) {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/approve", projectID, iid)
+
+	body := map[string]interface{}{}
+	if sha != "" {
+		body["sha"] = sha
+	}
+
+	var approvals ApprovalState
+	if err := c.post(path, body, &approvals); err != nil {
+		return nil, fmt.Errorf("approving MR: %w", err)
+	}
+
+	return &approvals, nil
+}
+
+func (c *Client) UnapproveMR(projectID, iid int) error {
+	path := fmt.Sprintf("/projects/%d/merge_requests/%d/unapprove", projectID, iid)
+
+	if err := c.post(path, nil, nil); err != nil {
+		return fmt.Errorf("unapproving MR: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Client) GetMRLabelEvents(projectID, iid int) ([]LabelEvent, error) {
 	path := fmt.Sprintf("/projects/%d/merge_requests/%d/resource_label_events?per_page=100", projectID, iid)
 
@@ -342,3 +421,81 @@ func (c *Client) GetMRPipelines(projectID, mrIID int) ([]PipelineInfo, error) {
 
 	return pipelines, nil
 }
+
+// RequestBody returns the JSON body CreateMR sends for these options.
+func (opts CreateMROptions) RequestBody() map[string]interface{} {
+	body := map[string]interface{}{
+		"source_branch": opts.SourceBranch,
+		"target_branch": opts.TargetBranch,
+		"title":         opts.Title,
+	}
+
+	if opts.Description != "" {
+		body["description"] = opts.Description
+	}
+
+	if opts.Draft {
+		body["draft"] = true
+	}
+
+	if opts.Squash {
+		body["squash"] = true
+	}
+
+	if opts.RemoveSourceBranch {
+		body["remove_source_branch"] = true
+	}
+
+	if opts.AllowCollaboration {
+		body["allow_collaboration"] = true
+	}
+
+	return body
+}
+
+// RequestBody returns the JSON body UpdateMR sends for these options. Only
+// fields that are set are included.
+func (opts UpdateMROptions) RequestBody() map[string]interface{} {
+	body := make(map[string]interface{})
+	if opts.Title != nil {
+		body["title"] = *opts.Title
+	}
+	if opts.Description != nil {
+		body["description"] = *opts.Description
+	}
+	if opts.TargetBranch != nil {
+		body["target_branch"] = *opts.TargetBranch
+	}
+	if opts.AssigneeIDs != nil {
+		body["assignee_ids"] = opts.AssigneeIDs
+	}
+	if opts.ReviewerIDs != nil {
+		body["reviewer_ids"] = opts.ReviewerIDs
+	}
+	if opts.Labels != nil {
+		body["labels"] = *opts.Labels
+	}
+	if opts.MilestoneID != nil {
+		body["milestone_id"] = *opts.MilestoneID
+	}
+	if opts.StateEvent != nil {
+		body["state_event"] = *opts.StateEvent
+	}
+	if opts.Draft != nil {
+		body["draft"] = *opts.Draft
+	}
+	if opts.RemoveSourceBranch != nil {
+		body["remove_source_branch"] = *opts.RemoveSourceBranch
+	}
+	if opts.Squash != nil {
+		body["squash"] = *opts.Squash
+	}
+	if opts.DiscussionLocked != nil {
+		body["discussion_locked"] = *opts.DiscussionLocked
+	}
+	if opts.AllowCollaboration != nil {
+		body["allow_collaboration"] = *opts.AllowCollaboration
+	}
+
+	return body
+}
@@ -0,0 +1,39 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListAllMRs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/api/v4/groups/my-group/merge_requests" || q.Get("state") != "merged" || q.Get("updated_after") != "2024-05-01T00:00:00Z" {
+			http.Error(w, "unexpected request "+r.URL.String(), http.StatusBadRequest)
+			return
+		}
+		page, _ := strconv.Atoi(q.Get("page"))
+		n := 100
+		if page == 2 {
+			n = 3
+		}
+		mrs := make([]MergeRequest, n)
+		for i := range mrs {
+			mrs[i].IID = (page-1)*100 + i + 1
+		}
+		json.NewEncoder(w).Encode(mrs)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "token")
+	mrs, err := client.ListAllMRs(ListMROptions{State: "merged", GroupID: "my-group", UpdatedAfter: "2024-05-01T00:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mrs) != 103 || mrs[102].IID != 103 {
+		t.Errorf("got %d MRs", len(mrs))
+	}
+}
@@ -23,6 +23,19 @@ func (c *Client) ListProjects(opts ListProjectsOptions) ([]Project, error) {
 		params.Set("owned", "true")
 	}
 
+	if opts.GroupID != "" {
+		if opts.IncludeSubgroups {
+			params.Set("include_subgroups", "true")
+		}
+		path := fmt.Sprintf("/groups/%s/projects?%s", url.PathEscape(opts.GroupID), params.Encode())
+
+		var projects []Project
+		if err := c.get(path, &projects); err != nil {
+			return nil, fmt.Errorf("listing group projects: %w", err)
+		}
+		return projects, nil
+	}
+
 	if opts.Membership {
 		params.Set("membership", "true")
 	} else {
@@ -51,3 +64,27 @@ func (c *Client) GetProjectByIDOrPath(idOrPath string) (*Project, error) {
 
 	return &project, nil
 }
+
+func (c *Client) GetProjectApprovals(projectID string) (*ProjectApprovals, error) {
+	path := fmt.Sprintf("/projects/%s/approvals", url.PathEscape(projectID))
+
+	var approvals ProjectApprovals
+	if err := c.get(path, &approvals); err != nil {
+		return nil, fmt.Errorf("getting project approvals: %w", err)
+	}
+
+	return &approvals, nil
+}
+
+// ListProjectApprovalRules returns the project-level approval rules
+// (GitLab Premium and above).
+func (c *Client) ListProjectApprovalRules(projectID string) ([]ApprovalRule, error) {
+	path := fmt.Sprintf("/projects/%s/approval_rules?per_page=100", url.PathEscape(projectID))
+
+	var rules []ApprovalRule
+	if err := c.get(path, &rules); err != nil {
+		return nil, fmt.Errorf("listing approval rules: %w", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ListRepositoryTree lists the entries of a directory of the repository at
+// ref. An empty ref means the default branch.
+func (c *Client) ListRepositoryTree(projectID, dir, ref string) ([]TreeEntry, error) {
+	params := url.Values{}
+	params.Set("path", dir)
+	params.Set("per_page", "100")
+	if ref != "" {
+		params.Set("ref", ref)
+	}
+
+	var all []TreeEntry
+	page := 1
+
+	for {
+		params.Set("page", strconv.Itoa(page))
+		path := fmt.Sprintf("/projects/%s/repository/tree?%s", url.PathEscape(projectID), params.Encode())
+
+		var entries []TreeEntry
+		if err := c.get(path, &entries); err != nil {
+			return nil, fmt.Errorf("listing %s: %w", dir, err)
+		}
+
+		all = append(all, entries...)
+		page++
+
+		if len(entries) < 100 {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+// GetRawFile returns the contents of a repository file at ref. An empty ref
+// means the default branch.
+func (c *Client) GetRawFile(projectID, filePath, ref string) ([]byte, error) {
+	path := fmt.Sprintf("/projects/%s/repository/files/%s/raw", url.PathEscape(projectID), url.PathEscape(filePath))
+	if ref != "" {
+		path += "?ref=" + url.QueryEscape(ref)
+	}
+
+	content, err := c.getRaw(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filePath, err)
+	}
+
+	return content, nil
+}
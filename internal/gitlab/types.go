@@ -7,27 +7,55 @@ type User struct {
 	Email     string `json:"email"`
 	AvatarURL string `json:"avatar_url"`
 	WebURL    string `json:"web_url"`
+	Bot       bool   `json:"bot,omitempty"`
 }
 
 type MergeRequest struct {
-	ID                  int       `json:"id"`
-	IID                 int       `json:"iid"`
-	ProjectID           int       `json:"project_id"`
-	Title               string    `json:"title"`
-	Description         string    `json:"description"`
-	State               string    `json:"state"`
-	SourceBranch        string    `json:"source_branch"`
-	TargetBranch        string    `json:"target_branch"`
-	Author              User      `json:"author"`
-	WebURL              string    `json:"web_url"`
-	DetailedMergeStatus string    `json:"detailed_merge_status"`
-	HasConflicts        bool      `json:"has_conflicts"`
-	RebaseInProgress    bool      `json:"rebase_in_progress"`
-	MergeError          string    `json:"merge_error"`
-	HeadPipeline        *Pipeline `json:"head_pipeline"`
-	Labels              []string  `json:"labels"`
-	Reviewers           []User    `json:"reviewers"`
-	Assignees           []User    `json:"assignees"`
+	ID                  int        `json:"id"`
+	IID                 int        `json:"iid"`
+	ProjectID           int        `json:"project_id"`
+	SourceProjectID     int        `json:"source_project_id"`
+	TargetProjectID     int        `json:"target_project_id"`
+	Title               string     `json:"title"`
+	Description         string     `json:"description"`
+	State               string     `json:"state"`
+	Draft               bool       `json:"draft"`
+	Squash              bool       `json:"squash"`
+	DiscussionLocked    bool       `json:"discussion_locked"`
+	AllowCollaboration  bool       `json:"allow_collaboration"`
+	ForceRemoveSource   bool       `json:"force_remove_source_branch"`
+	SourceBranch        string     `json:"source_branch"`
+	TargetBranch        string     `json:"target_branch"`
+	Author              User       `json:"author"`
+	WebURL              string     `json:"web_url"`
+	DetailedMergeStatus string     `json:"detailed_merge_status"`
+	HasConflicts        bool       `json:"has_conflicts"`
+	RebaseInProgress    bool       `json:"rebase_in_progress"`
+	MergeError          string     `json:"merge_error"`
+	HeadPipeline        *Pipeline  `json:"head_pipeline"`
+	SHA                 string     `json:"sha"`
+	CreatedAt           string     `json:"created_at"`
+	UpdatedAt           string     `json:"updated_at"`
+	MergedAt            string     `json:"merged_at"`
+	Milestone           *Milestone `json:"milestone"`
+	Labels              []string   `json:"labels"`
+	Reviewers           []User     `json:"reviewers"`
+	Assignees           []User     `json:"assignees"`
+	TimeStats           *TimeStats `json:"time_stats"`
+	References          References `json:"references"`
+}
+
+// References are the textual references of an MR or issue, e.g.
+// "group/repo!12".
+type References struct {
+	Short string `json:"short"`
+	Full  string `json:"full"`
+}
+
+// TimeStats is the time tracking of an MR or issue, in seconds.
+type TimeStats struct {
+	TimeEstimate   int `json:"time_estimate"`
+	TotalTimeSpent int `json:"total_time_spent"`
 }
 
 type Pipeline struct {
@@ -67,22 +95,27 @@ type ListMROptions struct {
 	State         string
 	Scope         string
 	ProjectID     int
+	GroupID       string // lists MRs of the group and its subgroups
 	AuthorID      int
+	ReviewerID    int
 	PerPage       int
 	ApprovedByIDs string
+	UpdatedAfter  string // ISO 8601 time
 }
 
 type Event struct {
-	ID          int       `json:"id"`
-	ActionName  string    `json:"action_name"`
-	CreatedAt   string    `json:"created_at"`
-	ProjectID   int       `json:"project_id"`
-	TargetType  string    `json:"target_type"`
-	TargetID    int       `json:"target_id"`
-	TargetIID   int       `json:"target_iid"`
-	TargetTitle string    `json:"target_title"`
-	PushData    *PushData `json:"push_data"`
-	Note        *NoteData `json:"note"`
+	ID             int       `json:"id"`
+	ActionName     string    `json:"action_name"`
+	CreatedAt      string    `json:"created_at"`
+	ProjectID      int       `json:"project_id"`
+	TargetType     string    `json:"target_type"`
+	TargetID       int       `json:"target_id"`
+	TargetIID      int       `json:"target_iid"`
+	TargetTitle    string    `json:"target_title"`
+	AuthorID       int       `json:"author_id"`
+	AuthorUsername string    `json:"author_username"`
+	PushData       *PushData `json:"push_data"`
+	Note           *NoteData `json:"note"`
 }
 
 type PushData struct {
@@ -91,19 +124,23 @@ type PushData struct {
 	RefType     string `json:"ref_type"`
 	Ref         string `json:"ref"`
 	CommitTitle string `json:"commit_title"`
+	CommitTo    string `json:"commit_to"`
 }
 
 type NoteData struct {
 	NoteableType string `json:"noteable_type"`
+	NoteableIID  int    `json:"noteable_iid"`
 	Body         string `json:"body"`
 }
 
 type Commit struct {
-	ID           string `json:"id"`
-	Title        string `json:"title"`
-	Message      string `json:"message"`
-	AuthoredDate string `json:"authored_date"`
-	AuthorName   string `json:"author_name"`
+	ID            string `json:"id"`
+	ShortID       string `json:"short_id"`
+	Title         string `json:"title"`
+	Message       string `json:"message"`
+	AuthoredDate  string `json:"authored_date"`
+	CommittedDate string `json:"committed_date"`
+	AuthorName    string `json:"author_name"`
 }
 
 type Project struct {
@@ -114,6 +151,28 @@ type Project struct {
 	Visibility        string `json:"visibility"`
 	WebURL            string `json:"web_url"`
 	DefaultBranch     string `json:"default_branch"`
+	Archived          bool   `json:"archived"`
+	Description       string `json:"description,omitempty"`
+	LastActivityAt    string `json:"last_activity_at,omitempty"`
+	OpenIssuesCount   int    `json:"open_issues_count"`
+
+	// Merge settings
+	MergeMethod                               string `json:"merge_method,omitempty"`
+	SquashOption                              string `json:"squash_option,omitempty"`
+	OnlyAllowMergeIfPipelineSucceeds          bool   `json:"only_allow_merge_if_pipeline_succeeds"`
+	AllowMergeOnSkippedPipeline               bool   `json:"allow_merge_on_skipped_pipeline"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved bool   `json:"only_allow_merge_if_all_discussions_are_resolved"`
+	RemoveSourceBranchAfterMerge              bool   `json:"remove_source_branch_after_merge"`
+}
+
+// ProjectApprovals holds the project-level approval settings. The endpoint
+// is only available on GitLab Premium and above.
+type ProjectApprovals struct {
+	ApprovalsBeforeMerge                      int  `json:"approvals_before_merge"`
+	ResetApprovalsOnPush                      bool `json:"reset_approvals_on_push"`
+	DisableOverridingApproversPerMergeRequest bool `json:"disable_overriding_approvers_per_merge_request"`
+	MergeRequestsAuthorApproval               bool `json:"merge_requests_author_approval"`
+	MergeRequestsDisableCommittersApproval    bool `json:"merge_requests_disable_committers_approval"`
 }
 
 type Label struct {
@@ -147,12 +206,60 @@ type DiffPosition struct {
 }
 
 type ApprovalState struct {
-	Approved  bool           `json:"approved"`
-	Approvers []ApprovalUser `json:"approved_by"`
+	Approved          bool           `json:"approved"`
+	ApprovalsRequired int            `json:"approvals_required"`
+	ApprovalsLeft     int            `json:"approvals_left"`
+	Approvers         []ApprovalUser `json:"approved_by"`
+	RulesOverwritten  bool           `json:"approval_rules_overwritten"`
+	Rules             []ApprovalRule `json:"rules"`
+}
+
+type ApprovalRule struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	RuleType          string `json:"rule_type"`
+	Section           string `json:"section"`
+	ApprovalsRequired int    `json:"approvals_required"`
+	Approved          bool   `json:"approved"`
+	Overridden        bool   `json:"overridden"`
+	EligibleApprovers []User `json:"eligible_approvers"`
+	ApprovedBy        []User `json:"approved_by"`
 }
 
 type ApprovalUser struct {
-	User User `json:"user"`
+	User       User   `json:"user"`
+	ApprovedAt string `json:"approved_at"` // not reported by older GitLab versions
+}
+
+type MRDiff struct {
+	OldPath     string `json:"old_path"`
+	NewPath     string `json:"new_path"`
+	AMode       string `json:"a_mode"`
+	BMode       string `json:"b_mode"`
+	Diff        string `json:"diff"`
+	NewFile     bool   `json:"new_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	DeletedFile bool   `json:"deleted_file"`
+	TooLarge    bool   `json:"too_large"`
+	Collapsed   bool   `json:"collapsed"`
+}
+
+type MRVersion struct {
+	ID             int      `json:"id"`
+	HeadCommitSHA  string   `json:"head_commit_sha"`
+	BaseCommitSHA  string   `json:"base_commit_sha"`
+	StartCommitSHA string   `json:"start_commit_sha"`
+	CreatedAt      string   `json:"created_at"`
+	State          string   `json:"state"`
+	RealSize       string   `json:"real_size"`
+	Diffs          []MRDiff `json:"diffs,omitempty"`
+}
+
+type CompareResult struct {
+	Commits        []Commit `json:"commits"`
+	Diffs          []MRDiff `json:"diffs"`
+	CompareTimeout bool     `json:"compare_timeout"`
+	CompareSameRef bool     `json:"compare_same_ref"`
 }
 
 type LabelEvent struct {
@@ -171,6 +278,7 @@ type ActivityEntry struct {
 	Source      string                 `json:"source,omitempty"`
 	Target      string                 `json:"target,omitempty"`
 	Task        string                 `json:"task,omitempty"`
+	Author      string                 `json:"author,omitempty"`
 	Description string                 `json:"description"`
 	Details     map[string]interface{} `json:"details,omitempty"`
 }
@@ -178,6 +286,12 @@ type ActivityEntry struct {
 type ListEventsOptions struct {
 	After  string
 	Before string
+
+	// Users selects the events of these users (IDs or usernames) instead
+	// of the token owner's. With ProjectID they filter the project's events
+	// by author.
+	Users     []string
+	ProjectID string
 }
 
 type CreateMROptions struct {
@@ -212,9 +326,250 @@ type ListProjectsOptions struct {
 	Owned      bool
 	Membership bool
 	PerPage    int
+
+	// GroupID lists the projects of a group instead of all accessible
+	// projects; IncludeSubgroups extends it to projects of subgroups.
+	GroupID          string
+	IncludeSubgroups bool
+}
+
+type Group struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	FullName    string `json:"full_name"`
+	FullPath    string `json:"full_path"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	ParentID    int    `json:"parent_id,omitempty"`
+	WebURL      string `json:"web_url"`
+}
+
+// ListGroupsOptions lists accessible groups or, when ParentID is set, the
+// subgroups of a group (all descendants with Recursive).
+type ListGroupsOptions struct {
+	Search    string
+	Owned     bool
+	ParentID  string
+	Recursive bool
+	PerPage   int
 }
 
 type ListUsersOptions struct {
 	Search  string
 	PerPage int
 }
+
+// UserStatus is the status a user sets on their profile.
+type UserStatus struct {
+	Emoji         string `json:"emoji"`
+	Message       string `json:"message"`
+	Availability  string `json:"availability"` // "not_set" or "busy"
+	ClearStatusAt string `json:"clear_status_at"`
+}
+
+type Issue struct {
+	ID             int        `json:"id"`
+	IID            int        `json:"iid"`
+	ProjectID      int        `json:"project_id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	State          string     `json:"state"`
+	Labels         []string   `json:"labels"`
+	Author         User       `json:"author"`
+	Assignees      []User     `json:"assignees"`
+	Milestone      *Milestone `json:"milestone"`
+	UserNotesCount int        `json:"user_notes_count"`
+	DueDate        string     `json:"due_date"`
+	CreatedAt      string     `json:"created_at"`
+	UpdatedAt      string     `json:"updated_at"`
+	ClosedAt       string     `json:"closed_at"`
+	WebURL         string     `json:"web_url"`
+	References     References `json:"references"`
+}
+
+type ListIssuesOptions struct {
+	ProjectID string
+	State     string
+	Scope     string
+	Labels    string
+	Search    string
+	PerPage   int
+}
+
+type CreateIssueOptions struct {
+	Title       string
+	Description string
+	Labels      []string
+	AssigneeIDs []int
+	DueDate     string
+}
+
+type UpdateIssueOptions struct {
+	Title        *string
+	Description  *string
+	Labels       *string
+	AddLabels    []string
+	RemoveLabels []string
+	AssigneeIDs  []int
+	DueDate      *string
+	StateEvent   *string
+}
+
+// TreeEntry is a file or directory of a repository tree listing.
+type TreeEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+type Branch struct {
+	Name               string `json:"name"`
+	Merged             bool   `json:"merged"`
+	Protected          bool   `json:"protected"`
+	Default            bool   `json:"default"`
+	DevelopersCanPush  bool   `json:"developers_can_push"`
+	DevelopersCanMerge bool   `json:"developers_can_merge"`
+	Commit             Commit `json:"commit"`
+	WebURL             string `json:"web_url"`
+}
+
+type ProtectedBranch struct {
+	ID                int           `json:"id"`
+	Name              string        `json:"name"`
+	PushAccessLevels  []AccessLevel `json:"push_access_levels"`
+	MergeAccessLevels []AccessLevel `json:"merge_access_levels"`
+	AllowForcePush    bool          `json:"allow_force_push"`
+}
+
+type AccessLevel struct {
+	AccessLevel            int    `json:"access_level"`
+	AccessLevelDescription string `json:"access_level_description"`
+}
+
+type ProtectBranchOptions struct {
+	Name             string
+	PushAccessLevel  int
+	MergeAccessLevel int
+	AllowForcePush   bool
+}
+
+type Tag struct {
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+	Target    string `json:"target"`
+	Protected bool   `json:"protected"`
+	Commit    Commit `json:"commit"`
+}
+
+type Milestone struct {
+	ID          int    `json:"id"`
+	IID         int    `json:"iid"`
+	ProjectID   int    `json:"project_id,omitempty"`
+	GroupID     int    `json:"group_id,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       string `json:"state"`
+	StartDate   string `json:"start_date"`
+	DueDate     string `json:"due_date"`
+	Expired     bool   `json:"expired"`
+	WebURL      string `json:"web_url"`
+}
+
+// ListMilestonesOptions lists milestones of a project or, when GroupID is
+// set, of a group.
+type ListMilestonesOptions struct {
+	ProjectID string
+	GroupID   string
+	State     string
+	Title     string
+	Search    string
+}
+
+type CreateMilestoneOptions struct {
+	Title       string
+	Description string
+	StartDate   string
+	DueDate     string
+}
+
+// Iteration is a group iteration (sprint). Projects see the iterations of
+// their ancestor groups.
+type Iteration struct {
+	ID          int    `json:"id"`
+	IID         int    `json:"iid"`
+	Sequence    int    `json:"sequence"`
+	GroupID     int    `json:"group_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	State       int    `json:"state"`
+	StartDate   string `json:"start_date"`
+	DueDate     string `json:"due_date"`
+	WebURL      string `json:"web_url"`
+}
+
+// StateName returns the name of the iteration's numeric state.
+func (i Iteration) StateName() string {
+	switch i.State {
+	case 1:
+		return "upcoming"
+	case 2:
+		return "current"
+	case 3:
+		return "closed"
+	}
+	return "unknown"
+}
+
+// ListIterationsOptions lists iterations of a project or, when GroupID is
+// set, of a group. State is opened, upcoming, current, closed or all.
+type ListIterationsOptions struct {
+	ProjectID string
+	GroupID   string
+	State     string
+	Search    string
+}
+
+type CreateLabelOptions struct {
+	Name        string
+	Color       string
+	Description string
+}
+
+type UpdateLabelOptions struct {
+	NewName     string
+	Color       string
+	Description *string
+}
+
+// FieldChange is a merge request field a request would change.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// ProjectHook is a project webhook. Only the event types gitlab-cli uses
+// are mapped.
+type ProjectHook struct {
+	ID                    int    `json:"id"`
+	URL                   string `json:"url"`
+	ProjectID             int    `json:"project_id"`
+	PushEvents            bool   `json:"push_events"`
+	MergeRequestsEvents   bool   `json:"merge_requests_events"`
+	PipelineEvents        bool   `json:"pipeline_events"`
+	NoteEvents            bool   `json:"note_events"`
+	EnableSSLVerification bool   `json:"enable_ssl_verification"`
+	CreatedAt             string `json:"created_at"`
+}
+
+type ProjectHookOptions struct {
+	URL                   string
+	Token                 string
+	PushEvents            bool
+	MergeRequestsEvents   bool
+	PipelineEvents        bool
+	NoteEvents            bool
+	EnableSSLVerification bool
+}
@@ -29,6 +29,16 @@ func (c *Client) ListUsers(opts ListUsersOptions) ([]User, error) {
 	return users, nil
 }
 
+// GetCurrentUser returns the user the token belongs to.
+func (c *Client) GetCurrentUser() (*User, error) {
+	var user User
+	if err := c.get("/user", &user); err != nil {
+		return nil, fmt.Errorf("getting current user: %w", err)
+	}
+
+	return &user, nil
+}
+
 func (c *Client) GetUserByUsername(username string) (*User, error) {
 	params := url.Values{}
 	params.Set("username", username)
@@ -47,6 +57,16 @@ func (c *Client) GetUserByUsername(username string) (*User, error) {
 	return &users[0], nil
 }
 
+// GetUserStatus returns the status the user has set, if any.
+func (c *Client) GetUserStatus(userID int) (*UserStatus, error) {
+	var status UserStatus
+	if err := c.get(fmt.Sprintf("/users/%d/status", userID), &status); err != nil {
+		return nil, fmt.Errorf("getting status of user %d: %w", userID, err)
+	}
+
+	return &status, nil
+}
+
 func (c *Client) ListProjectMembers(projectID string, search string) ([]User, error) {
 	encoded := url.PathEscape(projectID)
 	params := url.Values{}
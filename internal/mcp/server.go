@@ -6,6 +6,7 @@ import (
 	sdkmcp "github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/user/gitlab-cli/internal/config"
 	"github.com/user/gitlab-cli/internal/gitlab"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 // GitLabClient defines all gitlab.Client methods used by MCP tool handlers.
@@ -24,18 +25,36 @@ type GitLabClient interface {
 	CancelAutoMerge(projectID, iid int) error
 	GetMRDiscussions(projectID, iid int) ([]gitlab.Discussion, error)
 	GetMRApprovals(projectID, iid int) (*gitlab.ApprovalState, error)
+	ApproveMR(projectID, iid int, sha string) (*gitlab.ApprovalState, error)
+	UnapproveMR(projectID, iid int) error
 	GetMRPipelines(projectID, mrIID int) ([]gitlab.PipelineInfo, error)
+	GetMRDiffs(projectID, iid int) ([]gitlab.MRDiff, error)
 	GetEvents(opts gitlab.ListEventsOptions) ([]gitlab.Event, error)
 	ListProjects(opts gitlab.ListProjectsOptions) ([]gitlab.Project, error)
 	ListUsers(opts gitlab.ListUsersOptions) ([]gitlab.User, error)
 	ListProjectMembers(projectID string, search string) ([]gitlab.User, error)
 	ListProjectLabels(projectID string, search string) ([]gitlab.Label, error)
+	ListIssues(opts gitlab.ListIssuesOptions) ([]gitlab.Issue, error)
+	GetIssue(projectID string, iid int) (*gitlab.Issue, error)
+	CreateIssue(projectID string, opts gitlab.CreateIssueOptions) (*gitlab.Issue, error)
+	UpdateIssue(projectID string, iid int, opts gitlab.UpdateIssueOptions) (*gitlab.Issue, error)
+	CreateIssueNote(projectID string, iid int, body string) (*gitlab.Note, error)
+	GetIssueRelatedMRs(projectID string, iid int) ([]gitlab.MergeRequest, error)
+	GetIssueClosedBy(projectID string, iid int) ([]gitlab.MergeRequest, error)
+	GetMRClosesIssues(projectID, iid int) ([]gitlab.Issue, error)
+	ListMilestones(opts gitlab.ListMilestonesOptions) ([]gitlab.Milestone, error)
+	ResolveMilestoneID(projectID string, milestoneRef string) (int, error)
+	ListGroupMembers(groupID string, search string) ([]gitlab.User, error)
+	GetRawFile(projectID, filePath, ref string) ([]byte, error)
+	GetUserByUsername(username string) (*gitlab.User, error)
+	GetUserStatus(userID int) (*gitlab.UserStatus, error)
 }
 
 // Server holds the MCP server state.
 type Server struct {
 	client GitLabClient
 	config *config.Config
+	tasks  *task.Matcher
 }
 
 // NewServer creates a new MCP server with config loaded from environment/file.
@@ -49,8 +68,13 @@ func NewServer() (*Server, error) {
 		return nil, fmt.Errorf("%w: %v", ErrConfigValidate, err)
 	}
 
+	tasks, err := task.NewMatcher(cfg.Tasks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConfigValidate, err)
+	}
+
 	client := gitlab.NewClient(cfg.GitLabURL, cfg.GitLabToken)
-	return &Server{client: client, config: cfg}, nil
+	return &Server{client: client, config: cfg, tasks: tasks}, nil
 }
 
 // NewServerWithClient creates a server with an injected client (for testing).
@@ -58,10 +82,14 @@ func NewServerWithClient(client GitLabClient, cfg *config.Config) *Server {
 	if cfg == nil {
 		cfg = &config.Config{}
 	}
-	return &Server{client: client, config: cfg}
+	tasks, err := task.NewMatcher(cfg.Tasks)
+	if err != nil {
+		tasks = task.DefaultMatcher()
+	}
+	return &Server{client: client, config: cfg, tasks: tasks}
 }
 
-// RegisterTools registers all 16 MCP tools on the SDK server.
+// RegisterTools registers all 24 MCP tools on the SDK server.
 func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 	falseVal := false
 
@@ -96,9 +124,19 @@ func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 		},
 	}, s.MRResolveHandler)
 
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "mr-diff",
+		Description: "Show merge request file diffs, split into size-limited chunks with offset-based paging",
+		Annotations: &sdkmcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.MRDiffHandler)
+
 	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
 		Name:        "activity-list",
-		Description: "List recent GitLab activity events for the authenticated user",
+		Description: "List recent GitLab activity events of the authenticated user, other users, a team or a project",
 		Annotations: &sdkmcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			IdempotentHint:  true,
@@ -136,6 +174,36 @@ func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 		},
 	}, s.LabelListHandler)
 
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "issue-list",
+		Description: "List issues in a project or across accessible projects with state, label and search filters",
+		Annotations: &sdkmcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.IssueListHandler)
+
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "issue-show",
+		Description: "Show issue details with related merge requests and which of them close the issue",
+		Annotations: &sdkmcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.IssueShowHandler)
+
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "milestone-list",
+		Description: "List milestones of a project (including inherited group milestones) or of a group",
+		Annotations: &sdkmcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.MilestoneListHandler)
+
 	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
 		Name:        "config-show",
 		Description: "Show current gitlab-cli configuration (token masked)",
@@ -167,7 +235,7 @@ func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 
 	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
 		Name:        "mr-reviewer",
-		Description: "Add or remove reviewers on a merge request by user ID",
+		Description: "Add or remove reviewers on a merge request by user ID. suggest ranks the code owners of the changes (from CODEOWNERS) by review load and availability; auto adds the top N",
 		Annotations: &sdkmcp.ToolAnnotations{
 			IdempotentHint:  true,
 			DestructiveHint: &falseVal,
@@ -201,6 +269,24 @@ func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 		},
 	}, s.MRAutoMergeHandler)
 
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "mr-approve",
+		Description: "Approve a merge request (optionally pinned to a head SHA) or revoke your approval",
+		Annotations: &sdkmcp.ToolAnnotations{
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.MRApproveHandler)
+
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "issue-update",
+		Description: "Update issue properties: title, description, labels, assignees, due date, close or reopen",
+		Annotations: &sdkmcp.ToolAnnotations{
+			IdempotentHint:  true,
+			DestructiveHint: &falseVal,
+		},
+	}, s.IssueUpdateHandler)
+
 	// Non-idempotent tools
 	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
 		Name:        "mr-create",
@@ -211,4 +297,14 @@ func (s *Server) RegisterTools(sdkServer *sdkmcp.Server) {
 		Name:        "mr-merge",
 		Description: "Merge a merge request with optional auto-rebase and retry logic",
 	}, s.MRMergeHandler)
+
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "issue-create",
+		Description: "Create a new issue with optional labels, assignees and due date",
+	}, s.IssueCreateHandler)
+
+	sdkmcp.AddTool(sdkServer, &sdkmcp.Tool{
+		Name:        "issue-comment",
+		Description: "Add a comment to an issue",
+	}, s.IssueCommentHandler)
 }
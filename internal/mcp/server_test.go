@@ -149,12 +149,29 @@ type mockGitLabClient struct {
 	cancelAutoMergeFunc    func(projectID, iid int) error
 	getMRDiscussionsFunc   func(projectID, iid int) ([]gitlab.Discussion, error)
 	getMRApprovalsFunc     func(projectID, iid int) (*gitlab.ApprovalState, error)
+	approveMRFunc          func(projectID, iid int, sha string) (*gitlab.ApprovalState, error)
+	unapproveMRFunc        func(projectID, iid int) error
 	getMRPipelinesFunc     func(projectID, mrIID int) ([]gitlab.PipelineInfo, error)
+	getMRDiffsFunc         func(projectID, iid int) ([]gitlab.MRDiff, error)
 	getEventsFunc          func(opts gitlab.ListEventsOptions) ([]gitlab.Event, error)
 	listProjectsFunc       func(opts gitlab.ListProjectsOptions) ([]gitlab.Project, error)
 	listUsersFunc          func(opts gitlab.ListUsersOptions) ([]gitlab.User, error)
 	listProjectMembersFunc func(projectID string, search string) ([]gitlab.User, error)
 	listProjectLabelsFunc  func(projectID string, search string) ([]gitlab.Label, error)
+	listIssuesFunc         func(opts gitlab.ListIssuesOptions) ([]gitlab.Issue, error)
+	getIssueFunc           func(projectID string, iid int) (*gitlab.Issue, error)
+	createIssueFunc        func(projectID string, opts gitlab.CreateIssueOptions) (*gitlab.Issue, error)
+	updateIssueFunc        func(projectID string, iid int, opts gitlab.UpdateIssueOptions) (*gitlab.Issue, error)
+	createIssueNoteFunc    func(projectID string, iid int, body string) (*gitlab.Note, error)
+	getIssueRelatedMRsFunc func(projectID string, iid int) ([]gitlab.MergeRequest, error)
+	getIssueClosedByFunc   func(projectID string, iid int) ([]gitlab.MergeRequest, error)
+	getMRClosesIssuesFunc  func(projectID, iid int) ([]gitlab.Issue, error)
+	listMilestonesFunc     func(opts gitlab.ListMilestonesOptions) ([]gitlab.Milestone, error)
+	resolveMilestoneIDFunc func(projectID string, milestoneRef string) (int, error)
+	listGroupMembersFunc   func(groupID string, search string) ([]gitlab.User, error)
+	getRawFileFunc         func(projectID, filePath, ref string) ([]byte, error)
+	getUserByUsernameFunc  func(username string) (*gitlab.User, error)
+	getUserStatusFunc      func(userID int) (*gitlab.UserStatus, error)
 }
 
 func (m *mockGitLabClient) ListMRs(opts gitlab.ListMROptions) ([]gitlab.MergeRequest, error) {
@@ -255,6 +272,20 @@ func (m *mockGitLabClient) GetMRApprovals(projectID, iid int) (*gitlab.ApprovalS
 	return &gitlab.ApprovalState{}, nil
 }
 
+func (m *mockGitLabClient) ApproveMR(projectID, iid int, sha string) (*gitlab.ApprovalState, error) {
+	if m.approveMRFunc != nil {
+		return m.approveMRFunc(projectID, iid, sha)
+	}
+	return &gitlab.ApprovalState{Approved: true}, nil
+}
+
+func (m *mockGitLabClient) UnapproveMR(projectID, iid int) error {
+	if m.unapproveMRFunc != nil {
+		return m.unapproveMRFunc(projectID, iid)
+	}
+	return nil
+}
+
 func (m *mockGitLabClient) GetMRPipelines(projectID, mrIID int) ([]gitlab.PipelineInfo, error) {
 	if m.getMRPipelinesFunc != nil {
 		return m.getMRPipelinesFunc(projectID, mrIID)
@@ -262,6 +293,13 @@ func (m *mockGitLabClient) GetMRPipelines(projectID, mrIID int) ([]gitlab.Pipeli
 	return nil, nil
 }
 
+func (m *mockGitLabClient) GetMRDiffs(projectID, iid int) ([]gitlab.MRDiff, error) {
+	if m.getMRDiffsFunc != nil {
+		return m.getMRDiffsFunc(projectID, iid)
+	}
+	return nil, nil
+}
+
 func (m *mockGitLabClient) GetEvents(opts gitlab.ListEventsOptions) ([]gitlab.Event, error) {
 	if m.getEventsFunc != nil {
 		return m.getEventsFunc(opts)
@@ -296,3 +334,101 @@ func (m *mockGitLabClient) ListProjectLabels(projectID string, search string) ([
 	}
 	return nil, nil
 }
+
+func (m *mockGitLabClient) ListIssues(opts gitlab.ListIssuesOptions) ([]gitlab.Issue, error) {
+	if m.listIssuesFunc != nil {
+		return m.listIssuesFunc(opts)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) GetIssue(projectID string, iid int) (*gitlab.Issue, error) {
+	if m.getIssueFunc != nil {
+		return m.getIssueFunc(projectID, iid)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) CreateIssue(projectID string, opts gitlab.CreateIssueOptions) (*gitlab.Issue, error) {
+	if m.createIssueFunc != nil {
+		return m.createIssueFunc(projectID, opts)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) UpdateIssue(projectID string, iid int, opts gitlab.UpdateIssueOptions) (*gitlab.Issue, error) {
+	if m.updateIssueFunc != nil {
+		return m.updateIssueFunc(projectID, iid, opts)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) CreateIssueNote(projectID string, iid int, body string) (*gitlab.Note, error) {
+	if m.createIssueNoteFunc != nil {
+		return m.createIssueNoteFunc(projectID, iid, body)
+	}
+	return &gitlab.Note{}, nil
+}
+
+func (m *mockGitLabClient) GetIssueRelatedMRs(projectID string, iid int) ([]gitlab.MergeRequest, error) {
+	if m.getIssueRelatedMRsFunc != nil {
+		return m.getIssueRelatedMRsFunc(projectID, iid)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) GetIssueClosedBy(projectID string, iid int) ([]gitlab.MergeRequest, error) {
+	if m.getIssueClosedByFunc != nil {
+		return m.getIssueClosedByFunc(projectID, iid)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) GetMRClosesIssues(projectID, iid int) ([]gitlab.Issue, error) {
+	if m.getMRClosesIssuesFunc != nil {
+		return m.getMRClosesIssuesFunc(projectID, iid)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) ListMilestones(opts gitlab.ListMilestonesOptions) ([]gitlab.Milestone, error) {
+	if m.listMilestonesFunc != nil {
+		return m.listMilestonesFunc(opts)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) ResolveMilestoneID(projectID string, milestoneRef string) (int, error) {
+	if m.resolveMilestoneIDFunc != nil {
+		return m.resolveMilestoneIDFunc(projectID, milestoneRef)
+	}
+	return 0, nil
+}
+
+func (m *mockGitLabClient) ListGroupMembers(groupID string, search string) ([]gitlab.User, error) {
+	if m.listGroupMembersFunc != nil {
+		return m.listGroupMembersFunc(groupID, search)
+	}
+	return nil, nil
+}
+
+func (m *mockGitLabClient) GetRawFile(projectID, filePath, ref string) ([]byte, error) {
+	if m.getRawFileFunc != nil {
+		return m.getRawFileFunc(projectID, filePath, ref)
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockGitLabClient) GetUserByUsername(username string) (*gitlab.User, error) {
+	if m.getUserByUsernameFunc != nil {
+		return m.getUserByUsernameFunc(username)
+	}
+	return nil, errors.New("not found")
+}
+
+func (m *mockGitLabClient) GetUserStatus(userID int) (*gitlab.UserStatus, error) {
+	if m.getUserStatusFunc != nil {
+		return m.getUserStatusFunc(userID)
+	}
+	return &gitlab.UserStatus{}, nil
+}
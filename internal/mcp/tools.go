@@ -4,21 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	sdkmcp "github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/user/gitlab-cli/internal/gitlab"
 	"github.com/user/gitlab-cli/internal/mergeops"
+	"github.com/user/gitlab-cli/internal/reviewers"
+	"github.com/user/gitlab-cli/internal/task"
 )
 
 // --- mr-list ---
 
 type MRListInput struct {
-	Mine      bool `json:"mine,omitempty"      jsonschema:"Only MRs assigned to me"`
-	Approved  bool `json:"approved,omitempty"   jsonschema:"Only approved MRs"`
-	ProjectID int  `json:"project_id,omitempty" jsonschema:"Filter by project ID"`
+	Mine      bool   `json:"mine,omitempty"      jsonschema:"Only MRs assigned to me"`
+	Approved  bool   `json:"approved,omitempty"   jsonschema:"Only approved MRs"`
+	ProjectID int    `json:"project_id,omitempty" jsonschema:"Filter by project ID"`
+	Group     string `json:"group,omitempty"      jsonschema:"Only MRs of this group and its subgroups (ID or path)"`
 }
 
 type MRListOutput struct {
@@ -34,9 +38,13 @@ func (s *Server) MRListHandler(ctx context.Context, req *sdkmcp.CallToolRequest,
 	if input.Approved {
 		opts.ApprovedByIDs = "Any"
 	}
+	if input.ProjectID > 0 && input.Group != "" {
+		return nil, MRListOutput{}, fmt.Errorf("%w: project_id and group are mutually exclusive", ErrInvalidInput)
+	}
 	if input.ProjectID > 0 {
 		opts.ProjectID = input.ProjectID
 	}
+	opts.GroupID = input.Group
 
 	mrs, err := s.client.ListMRs(opts)
 	if err != nil {
@@ -96,6 +104,13 @@ func (s *Server) MRShowHandler(ctx context.Context, req *sdkmcp.CallToolRequest,
 		} else {
 			output.Approvals = toApprovalOutput(approvals)
 		}
+
+		issues, err := s.client.GetMRClosesIssues(input.ProjectID, input.MRIID)
+		if err != nil {
+			output.Warnings = append(output.Warnings, fmt.Sprintf("failed to fetch closing issues: %v", err))
+		} else if len(issues) > 0 {
+			output.ClosesIssues = toIssueSummaries(issues)
+		}
 	}
 
 	return nil, output, nil
@@ -115,10 +130,13 @@ type MRCreateInput struct {
 	Labels             []string `json:"labels,omitempty"         jsonschema:"Labels to apply after creation"`
 	ReviewerIDs        []int    `json:"reviewer_ids,omitempty"   jsonschema:"User IDs to add as reviewers"`
 	AssigneeIDs        []int    `json:"assignee_ids,omitempty"   jsonschema:"User IDs to assign to the MR"`
+	Closes             []int    `json:"closes,omitempty"         jsonschema:"Issue IIDs in the same project to close on merge (adds Closes #N to the description)"`
+	DryRun             bool     `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRCreateOutput struct {
-	MergeRequest MRSummary `json:"merge_request"`
+	MergeRequest MRSummary     `json:"merge_request"`
+	DryRun       *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRCreateHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRCreateInput) (*sdkmcp.CallToolResult, MRCreateOutput, error) {
@@ -126,16 +144,42 @@ func (s *Server) MRCreateHandler(ctx context.Context, req *sdkmcp.CallToolReques
 		return nil, MRCreateOutput{}, fmt.Errorf("%w: project, source_branch, target_branch, and title are required", ErrMissingParam)
 	}
 
+	description := input.Description
+	if len(input.Closes) > 0 {
+		lines := make([]string, len(input.Closes))
+		for i, iid := range input.Closes {
+			lines[i] = fmt.Sprintf("Closes #%d", iid)
+		}
+		if strings.TrimSpace(description) != "" {
+			description = strings.TrimRight(description, "\n") + "\n\n"
+		}
+		description += strings.Join(lines, "\n")
+	}
+
 	opts := gitlab.CreateMROptions{
 		SourceBranch:       input.SourceBranch,
 		TargetBranch:       input.TargetBranch,
 		Title:              input.Title,
-		Description:        input.Description,
+		Description:        description,
 		Draft:              input.Draft,
 		Squash:             input.Squash,
 		RemoveSourceBranch: input.RemoveSourceBranch,
 	}
 
+	if input.DryRun {
+		dry := &DryRunOutput{Requests: []DryRunRequest{{Action: "create merge request", Body: opts.RequestBody()}}}
+		if len(input.Labels) > 0 {
+			dry.Requests = append(dry.Requests, DryRunRequest{Action: "set labels", Body: map[string]interface{}{"labels": strings.Join(input.Labels, ",")}})
+		}
+		if len(input.ReviewerIDs) > 0 {
+			dry.Requests = append(dry.Requests, DryRunRequest{Action: "set reviewers", Body: map[string]interface{}{"reviewer_ids": input.ReviewerIDs}})
+		}
+		if len(input.AssigneeIDs) > 0 {
+			dry.Requests = append(dry.Requests, DryRunRequest{Action: "set assignees", Body: map[string]interface{}{"assignee_ids": input.AssigneeIDs}})
+		}
+		return nil, MRCreateOutput{DryRun: dry}, nil
+	}
+
 	mr, err := s.client.CreateMR(input.Project, opts)
 	if err != nil {
 		return nil, MRCreateOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
@@ -176,11 +220,13 @@ type MRMergeInput struct {
 	AutoRebase bool   `json:"auto_rebase,omitempty"   jsonschema:"Automatically rebase if needed"`
 	MaxRetries int    `json:"max_retries,omitempty"   jsonschema:"Max rebase attempts (default 3)"`
 	Timeout    string `json:"timeout,omitempty"       jsonschema:"Overall timeout duration (default 5m)"`
+	DryRun     bool   `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRMergeOutput struct {
-	Merged   bool `json:"merged"`
-	Attempts int  `json:"attempts"`
+	Merged   bool          `json:"merged"`
+	Attempts int           `json:"attempts"`
+	DryRun   *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRMergeHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRMergeInput) (*sdkmcp.CallToolResult, MRMergeOutput, error) {
@@ -202,6 +248,19 @@ func (s *Server) MRMergeHandler(ctx context.Context, req *sdkmcp.CallToolRequest
 		maxRetries = input.MaxRetries
 	}
 
+	if input.DryRun {
+		mr, err := s.client.GetMR(input.ProjectID, input.MRIID)
+		if err != nil {
+			return nil, MRMergeOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		dry := &DryRunOutput{Changes: []gitlab.FieldChange{{Field: "state", Before: mr.State, After: "merged"}}}
+		if input.AutoRebase && mr.DetailedMergeStatus == "need_rebase" {
+			dry.Requests = append(dry.Requests, DryRunRequest{Action: "rebase merge request"})
+		}
+		dry.Requests = append(dry.Requests, DryRunRequest{Action: "merge merge request"})
+		return nil, MRMergeOutput{DryRun: dry}, nil
+	}
+
 	opts := mergeops.MergeOptions{
 		ProjectID:    input.ProjectID,
 		MRIID:        input.MRIID,
@@ -222,13 +281,15 @@ func (s *Server) MRMergeHandler(ctx context.Context, req *sdkmcp.CallToolRequest
 // --- mr-rebase ---
 
 type MRRebaseInput struct {
-	ProjectID int `json:"project_id" jsonschema:"Project ID,required"`
-	MRIID     int `json:"mr_iid"     jsonschema:"Merge request IID,required"`
+	ProjectID int  `json:"project_id" jsonschema:"Project ID,required"`
+	MRIID     int  `json:"mr_iid"     jsonschema:"Merge request IID,required"`
+	DryRun    bool `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRRebaseOutput struct {
-	Rebased     bool   `json:"rebased"`
-	MergeStatus string `json:"merge_status"`
+	Rebased     bool          `json:"rebased"`
+	MergeStatus string        `json:"merge_status"`
+	DryRun      *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRRebaseHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRRebaseInput) (*sdkmcp.CallToolResult, MRRebaseOutput, error) {
@@ -236,6 +297,17 @@ func (s *Server) MRRebaseHandler(ctx context.Context, req *sdkmcp.CallToolReques
 		return nil, MRRebaseOutput{}, fmt.Errorf("%w: project_id and mr_iid are required", ErrMissingParam)
 	}
 
+	if input.DryRun {
+		mr, err := s.client.GetMR(input.ProjectID, input.MRIID)
+		if err != nil {
+			return nil, MRRebaseOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		return nil, MRRebaseOutput{
+			MergeStatus: mr.DetailedMergeStatus,
+			DryRun:      &DryRunOutput{Requests: []DryRunRequest{{Action: "rebase merge request"}}},
+		}, nil
+	}
+
 	if err := s.client.RebaseMR(input.ProjectID, input.MRIID); err != nil {
 		return nil, MRRebaseOutput{}, fmt.Errorf("%w: %v", ErrRebaseFailed, err)
 	}
@@ -285,10 +357,12 @@ type MRLabelInput struct {
 	MRIID     int      `json:"mr_iid"     jsonschema:"Merge request IID,required"`
 	Add       []string `json:"add,omitempty"    jsonschema:"Labels to add"`
 	Remove    []string `json:"remove,omitempty" jsonschema:"Labels to remove"`
+	DryRun    bool     `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRLabelOutput struct {
-	Labels []string `json:"labels"`
+	Labels []string      `json:"labels"`
+	DryRun *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRLabelHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRLabelInput) (*sdkmcp.CallToolResult, MRLabelOutput, error) {
@@ -322,6 +396,15 @@ func (s *Server) MRLabelHandler(ctx context.Context, req *sdkmcp.CallToolRequest
 	for l := range labelSet {
 		newLabels = append(newLabels, l)
 	}
+	slices.Sort(newLabels)
+
+	if input.DryRun {
+		joined := strings.Join(newLabels, ",")
+		return nil, MRLabelOutput{
+			Labels: mr.Labels,
+			DryRun: previewMRUpdate(mr, "set labels", gitlab.UpdateMROptions{Labels: &joined}),
+		}, nil
+	}
 
 	mr, err = s.client.UpdateMRLabels(input.ProjectID, input.MRIID, newLabels)
 	if err != nil {
@@ -338,10 +421,15 @@ type MRReviewerInput struct {
 	MRIID     int   `json:"mr_iid"           jsonschema:"Merge request IID,required"`
 	Add       []int `json:"add,omitempty"     jsonschema:"User IDs to add as reviewers"`
 	Remove    []int `json:"remove,omitempty"  jsonschema:"User IDs to remove from reviewers"`
+	Suggest   bool  `json:"suggest,omitempty" jsonschema:"Rank the code owners of the changes by review load and availability"`
+	Auto      int   `json:"auto,omitempty"    jsonschema:"Add the top N suggested reviewers"`
+	DryRun    bool  `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRReviewerOutput struct {
-	Reviewers []UserSummary `json:"reviewers"`
+	Reviewers   []UserSummary        `json:"reviewers"`
+	Suggestions []ReviewerSuggestion `json:"suggestions,omitempty"`
+	DryRun      *DryRunOutput        `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRReviewerHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRReviewerInput) (*sdkmcp.CallToolResult, MRReviewerOutput, error) {
@@ -354,9 +442,39 @@ func (s *Server) MRReviewerHandler(ctx context.Context, req *sdkmcp.CallToolRequ
 		return nil, MRReviewerOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
 	}
 
+	if input.Auto < 0 {
+		return nil, MRReviewerOutput{}, fmt.Errorf("%w: auto must not be negative", ErrInvalidInput)
+	}
+
+	var suggestions []ReviewerSuggestion
+	add := input.Add
+	if input.Suggest || input.Auto > 0 {
+		candidates, err := reviewers.Suggest(s.client, mr)
+		if errors.Is(err, reviewers.ErrNoCodeOwners) {
+			return nil, MRReviewerOutput{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		if err != nil {
+			return nil, MRReviewerOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		for _, c := range candidates {
+			suggestions = append(suggestions, ReviewerSuggestion{
+				UserSummary: UserSummary{ID: c.User.ID, Username: c.User.Username, Name: c.User.Name},
+				Files:       c.Files,
+				Load:        c.Load,
+				Score:       c.Score,
+				Busy:        c.Busy,
+				Status:      c.Status,
+				Reviewing:   c.Reviewer,
+			})
+		}
+		for _, c := range reviewers.Pick(candidates, input.Auto) {
+			add = append(add, c.User.ID)
+		}
+	}
+
 	// If no add/remove, just return current reviewers
-	if len(input.Add) == 0 && len(input.Remove) == 0 {
-		return nil, MRReviewerOutput{Reviewers: toUserSummaries(mr.Reviewers)}, nil
+	if len(add) == 0 && len(input.Remove) == 0 {
+		return nil, MRReviewerOutput{Reviewers: toUserSummaries(mr.Reviewers), Suggestions: suggestions}, nil
 	}
 
 	// Compute new reviewer set
@@ -364,7 +482,7 @@ func (s *Server) MRReviewerHandler(ctx context.Context, req *sdkmcp.CallToolRequ
 	for _, r := range mr.Reviewers {
 		reviewerSet[r.ID] = true
 	}
-	for _, id := range input.Add {
+	for _, id := range add {
 		reviewerSet[id] = true
 	}
 	for _, id := range input.Remove {
@@ -375,13 +493,22 @@ func (s *Server) MRReviewerHandler(ctx context.Context, req *sdkmcp.CallToolRequ
 	for id := range reviewerSet {
 		newIDs = append(newIDs, id)
 	}
+	slices.Sort(newIDs)
+
+	if input.DryRun {
+		return nil, MRReviewerOutput{
+			Reviewers:   toUserSummaries(mr.Reviewers),
+			Suggestions: suggestions,
+			DryRun:      previewMRUpdate(mr, "set reviewers", gitlab.UpdateMROptions{ReviewerIDs: newIDs}),
+		}, nil
+	}
 
 	mr, err = s.client.UpdateMRReviewers(input.ProjectID, input.MRIID, newIDs)
 	if err != nil {
 		return nil, MRReviewerOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
 	}
 
-	return nil, MRReviewerOutput{Reviewers: toUserSummaries(mr.Reviewers)}, nil
+	return nil, MRReviewerOutput{Reviewers: toUserSummaries(mr.Reviewers), Suggestions: suggestions}, nil
 }
 
 // --- mr-assignee ---
@@ -391,10 +518,12 @@ type MRAssigneeInput struct {
 	MRIID     int   `json:"mr_iid"           jsonschema:"Merge request IID,required"`
 	Add       []int `json:"add,omitempty"     jsonschema:"User IDs to add as assignees"`
 	Remove    []int `json:"remove,omitempty"  jsonschema:"User IDs to remove from assignees"`
+	DryRun    bool  `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRAssigneeOutput struct {
 	Assignees []UserSummary `json:"assignees"`
+	DryRun    *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRAssigneeHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRAssigneeInput) (*sdkmcp.CallToolResult, MRAssigneeOutput, error) {
@@ -430,6 +559,13 @@ func (s *Server) MRAssigneeHandler(ctx context.Context, req *sdkmcp.CallToolRequ
 	}
 	slices.Sort(newIDs)
 
+	if input.DryRun {
+		return nil, MRAssigneeOutput{
+			Assignees: toUserSummaries(mr.Assignees),
+			DryRun:    previewMRUpdate(mr, "set assignees", gitlab.UpdateMROptions{AssigneeIDs: newIDs}),
+		}, nil
+	}
+
 	mr, err = s.client.UpdateMRAssignees(input.ProjectID, input.MRIID, newIDs)
 	if err != nil {
 		return nil, MRAssigneeOutput{}, fmt.Errorf("%w: %w", ErrGitLabAPI, err)
@@ -450,16 +586,19 @@ type MRUpdateInput struct {
 	ReviewerIDs        []int   `json:"reviewer_ids,omitempty"         jsonschema:"Replace all reviewers by user ID"`
 	Labels             *string `json:"labels,omitempty"               jsonschema:"Replace all labels (comma-separated)"`
 	MilestoneID        *int    `json:"milestone_id,omitempty"         jsonschema:"Milestone ID (0 to unassign)"`
+	Milestone          *string `json:"milestone,omitempty"            jsonschema:"Milestone title, resolved to its ID (alternative to milestone_id)"`
 	StateEvent         *string `json:"state_event,omitempty"          jsonschema:"State transition: close or reopen"`
 	Draft              *bool   `json:"draft,omitempty"                jsonschema:"Mark as draft"`
 	RemoveSourceBranch *bool   `json:"remove_source_branch,omitempty" jsonschema:"Remove source branch after merge"`
 	Squash             *bool   `json:"squash,omitempty"               jsonschema:"Squash commits on merge"`
 	DiscussionLocked   *bool   `json:"discussion_locked,omitempty"    jsonschema:"Lock MR discussion"`
 	AllowCollaboration *bool   `json:"allow_collaboration,omitempty"  jsonschema:"Allow upstream member commits"`
+	DryRun             bool    `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRUpdateOutput struct {
-	MergeRequest MRSummary `json:"merge_request"`
+	MergeRequest MRSummary     `json:"merge_request"`
+	DryRun       *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRUpdateHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRUpdateInput) (*sdkmcp.CallToolResult, MRUpdateOutput, error) {
@@ -471,6 +610,17 @@ func (s *Server) MRUpdateHandler(ctx context.Context, req *sdkmcp.CallToolReques
 		return nil, MRUpdateOutput{}, fmt.Errorf("%w: state_event must be \"close\" or \"reopen\"", ErrInvalidInput)
 	}
 
+	if input.Milestone != nil {
+		if input.MilestoneID != nil {
+			return nil, MRUpdateOutput{}, fmt.Errorf("%w: milestone and milestone_id are mutually exclusive", ErrInvalidInput)
+		}
+		id, err := s.client.ResolveMilestoneID(strconv.Itoa(input.ProjectID), *input.Milestone)
+		if err != nil {
+			return nil, MRUpdateOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		input.MilestoneID = &id
+	}
+
 	opts := gitlab.UpdateMROptions{
 		Title:              input.Title,
 		Description:        input.Description,
@@ -487,6 +637,17 @@ func (s *Server) MRUpdateHandler(ctx context.Context, req *sdkmcp.CallToolReques
 		AllowCollaboration: input.AllowCollaboration,
 	}
 
+	if input.DryRun {
+		mr, err := s.client.GetMR(input.ProjectID, input.MRIID)
+		if err != nil {
+			return nil, MRUpdateOutput{}, fmt.Errorf("%w: %w", ErrGitLabAPI, err)
+		}
+		return nil, MRUpdateOutput{
+			MergeRequest: toMRSummary(*mr),
+			DryRun:       previewMRUpdate(mr, "update merge request", opts),
+		}, nil
+	}
+
 	mr, err := s.client.UpdateMR(input.ProjectID, input.MRIID, opts)
 	if err != nil {
 		return nil, MRUpdateOutput{}, fmt.Errorf("%w: %w", ErrGitLabAPI, err)
@@ -501,10 +662,12 @@ type MRAutoMergeInput struct {
 	ProjectID int  `json:"project_id" jsonschema:"Project ID,required"`
 	MRIID     int  `json:"mr_iid"     jsonschema:"Merge request IID,required"`
 	Cancel    bool `json:"cancel,omitempty" jsonschema:"Cancel auto-merge instead of enabling it"`
+	DryRun    bool `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
 }
 
 type MRAutoMergeOutput struct {
-	Enabled bool `json:"enabled"`
+	Enabled bool          `json:"enabled"`
+	DryRun  *DryRunOutput `json:"dry_run,omitempty"`
 }
 
 func (s *Server) MRAutoMergeHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRAutoMergeInput) (*sdkmcp.CallToolResult, MRAutoMergeOutput, error) {
@@ -512,6 +675,17 @@ func (s *Server) MRAutoMergeHandler(ctx context.Context, req *sdkmcp.CallToolReq
 		return nil, MRAutoMergeOutput{}, fmt.Errorf("%w: project_id and mr_iid are required", ErrMissingParam)
 	}
 
+	if input.DryRun {
+		action := "enable auto-merge"
+		if input.Cancel {
+			action = "cancel auto-merge"
+		}
+		return nil, MRAutoMergeOutput{
+			Enabled: !input.Cancel,
+			DryRun:  &DryRunOutput{Requests: []DryRunRequest{{Action: action}}},
+		}, nil
+	}
+
 	if input.Cancel {
 		if err := s.client.CancelAutoMerge(input.ProjectID, input.MRIID); err != nil {
 			return nil, MRAutoMergeOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
@@ -525,6 +699,217 @@ func (s *Server) MRAutoMergeHandler(ctx context.Context, req *sdkmcp.CallToolReq
 	return nil, MRAutoMergeOutput{Enabled: true}, nil
 }
 
+// --- mr-approve ---
+
+type MRApproveInput struct {
+	ProjectID int    `json:"project_id"          jsonschema:"Project ID,required"`
+	MRIID     int    `json:"mr_iid"              jsonschema:"Merge request IID,required"`
+	SHA       string `json:"sha,omitempty"       jsonschema:"Only approve if the MR head matches this commit SHA"`
+	Unapprove bool   `json:"unapprove,omitempty" jsonschema:"Revoke your approval instead of approving"`
+	DryRun    bool   `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
+}
+
+type MRApproveOutput struct {
+	Approvals ApprovalOutput `json:"approvals"`
+	DryRun    *DryRunOutput  `json:"dry_run,omitempty"`
+}
+
+func (s *Server) MRApproveHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRApproveInput) (*sdkmcp.CallToolResult, MRApproveOutput, error) {
+	if input.ProjectID == 0 || input.MRIID == 0 {
+		return nil, MRApproveOutput{}, fmt.Errorf("%w: project_id and mr_iid are required", ErrMissingParam)
+	}
+
+	if input.DryRun {
+		state, err := s.client.GetMRApprovals(input.ProjectID, input.MRIID)
+		if err != nil {
+			return nil, MRApproveOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		request := DryRunRequest{Action: "approve merge request"}
+		if input.Unapprove {
+			request.Action = "revoke approval"
+		} else if input.SHA != "" {
+			request.Body = map[string]interface{}{"sha": input.SHA}
+		}
+		return nil, MRApproveOutput{
+			Approvals: *toApprovalOutput(state),
+			DryRun:    &DryRunOutput{Requests: []DryRunRequest{request}},
+		}, nil
+	}
+
+	if input.Unapprove {
+		if err := s.client.UnapproveMR(input.ProjectID, input.MRIID); err != nil {
+			return nil, MRApproveOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		state, err := s.client.GetMRApprovals(input.ProjectID, input.MRIID)
+		if err != nil {
+			return nil, MRApproveOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		return nil, MRApproveOutput{Approvals: *toApprovalOutput(state)}, nil
+	}
+
+	state, err := s.client.ApproveMR(input.ProjectID, input.MRIID, input.SHA)
+	if err != nil {
+		return nil, MRApproveOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	return nil, MRApproveOutput{Approvals: *toApprovalOutput(state)}, nil
+}
+
+// --- mr-diff ---
+
+const (
+	defaultDiffChunkBytes = 16000
+	defaultDiffTotalBytes = 64000
+)
+
+type MRDiffInput struct {
+	ProjectID     int      `json:"project_id"                jsonschema:"Project ID,required"`
+	MRIID         int      `json:"mr_iid"                    jsonschema:"Merge request IID,required"`
+	Paths         []string `json:"paths,omitempty"           jsonschema:"Only include files matching these globs (supports **)"`
+	Exclude       []string `json:"exclude,omitempty"         jsonschema:"Exclude files matching these globs"`
+	NameOnly      bool     `json:"name_only,omitempty"       jsonschema:"Return file names and line counts without diff bodies"`
+	Offset        int      `json:"offset,omitempty"          jsonschema:"Chunk index to start from (next_offset of a previous call)"`
+	MaxChunkBytes int      `json:"max_chunk_bytes,omitempty" jsonschema:"Maximum size of a single diff chunk (default 16000)"`
+	MaxTotalBytes int      `json:"max_total_bytes,omitempty" jsonschema:"Maximum combined diff size per call (default 64000)"`
+}
+
+type MRDiffOutput struct {
+	Files       []DiffChunkOutput `json:"files"`
+	TotalFiles  int               `json:"total_files"`
+	TotalChunks int               `json:"total_chunks"`
+	NextOffset  int               `json:"next_offset,omitempty"`
+}
+
+func (s *Server) MRDiffHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MRDiffInput) (*sdkmcp.CallToolResult, MRDiffOutput, error) {
+	if input.ProjectID == 0 || input.MRIID == 0 {
+		return nil, MRDiffOutput{}, fmt.Errorf("%w: project_id and mr_iid are required", ErrMissingParam)
+	}
+	if input.Offset < 0 {
+		return nil, MRDiffOutput{}, fmt.Errorf("%w: offset must not be negative", ErrInvalidInput)
+	}
+
+	maxChunk := input.MaxChunkBytes
+	if maxChunk <= 0 {
+		maxChunk = defaultDiffChunkBytes
+	}
+	maxTotal := input.MaxTotalBytes
+	if maxTotal <= 0 {
+		maxTotal = defaultDiffTotalBytes
+	}
+
+	diffs, err := s.client.GetMRDiffs(input.ProjectID, input.MRIID)
+	if err != nil {
+		return nil, MRDiffOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+	diffs = gitlab.FilterDiffs(diffs, input.Paths, input.Exclude)
+
+	var chunks []DiffChunkOutput
+	for _, d := range diffs {
+		additions, deletions := gitlab.DiffStats(d.Diff)
+		base := DiffChunkOutput{
+			Path:        d.NewPath,
+			NewFile:     d.NewFile,
+			DeletedFile: d.DeletedFile,
+			RenamedFile: d.RenamedFile,
+			Additions:   additions,
+			Deletions:   deletions,
+			Chunk:       1,
+			Chunks:      1,
+		}
+		if d.RenamedFile {
+			base.OldPath = d.OldPath
+		}
+
+		switch {
+		case d.TooLarge || d.Collapsed:
+			base.Omitted = "diff too large, omitted by GitLab"
+			chunks = append(chunks, base)
+		case input.NameOnly:
+			chunks = append(chunks, base)
+		default:
+			parts := splitDiffChunks(d.Diff, maxChunk)
+			for i, part := range parts {
+				c := base
+				c.Chunk = i + 1
+				c.Chunks = len(parts)
+				c.Diff = part
+				chunks = append(chunks, c)
+			}
+		}
+	}
+
+	output := MRDiffOutput{
+		Files:       []DiffChunkOutput{},
+		TotalFiles:  len(diffs),
+		TotalChunks: len(chunks),
+	}
+
+	total := 0
+	for i := input.Offset; i < len(chunks); i++ {
+		size := len(chunks[i].Diff)
+		if len(output.Files) > 0 && total+size > maxTotal {
+			output.NextOffset = i
+			break
+		}
+		output.Files = append(output.Files, chunks[i])
+		total += size
+	}
+
+	return nil, output, nil
+}
+
+// splitDiffChunks splits a diff body into chunks of at most maxBytes,
+// breaking at hunk boundaries where possible and at line boundaries
+// otherwise. A single line longer than maxBytes becomes its own chunk.
+func splitDiffChunks(diff string, maxBytes int) []string {
+	if len(diff) <= maxBytes {
+		return []string{diff}
+	}
+
+	var hunks []string
+	var current strings.Builder
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(line, "@@") && current.Len() > 0 {
+			hunks = append(hunks, current.String())
+			current.Reset()
+		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		hunks = append(hunks, current.String())
+	}
+
+	var chunks []string
+	current.Reset()
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, hunk := range hunks {
+		if current.Len()+len(hunk) <= maxBytes {
+			current.WriteString(hunk)
+			continue
+		}
+		flush()
+		if len(hunk) <= maxBytes {
+			current.WriteString(hunk)
+			continue
+		}
+		for _, line := range strings.SplitAfter(hunk, "\n") {
+			if current.Len() > 0 && current.Len()+len(line) > maxBytes {
+				flush()
+			}
+			current.WriteString(line)
+		}
+	}
+	flush()
+
+	return chunks
+}
+
 // --- mr-resolve ---
 
 type MRResolveInput struct {
@@ -562,12 +947,11 @@ func (s *Server) MRResolveHandler(ctx context.Context, req *sdkmcp.CallToolReque
 		return nil, buildMRResolveResult(iidMatches[0], "iid", iidMatches), nil
 	}
 
-	// Priority 2: Task# match (regex #NNNNN with word boundary)
-	pattern := fmt.Sprintf(`#%d(?:[^a-zA-Z0-9]|$)`, input.Identifier)
-	re := regexp.MustCompile(pattern)
+	// Priority 2: Task# match (configured task patterns, word boundary)
+	id := strconv.Itoa(input.Identifier)
 	var taskMatches []gitlab.MergeRequest
 	for _, mr := range mrs {
-		if re.MatchString(mr.Title) {
+		if s.tasks.Mentions(task.ProjectFromReference(mr.References.Full), mr.Title, id) {
 			taskMatches = append(taskMatches, mr)
 		}
 	}
@@ -614,9 +998,12 @@ func buildMRResolveResult(mr gitlab.MergeRequest, matchType string, allMatches [
 // --- activity-list ---
 
 type ActivityListInput struct {
-	DaysAgo int    `json:"days_ago,omitempty" jsonschema:"Number of days back to fetch (default 30)"`
-	After   string `json:"after,omitempty"    jsonschema:"Start date (YYYY-MM-DD)"`
-	Before  string `json:"before,omitempty"   jsonschema:"End date (YYYY-MM-DD)"`
+	DaysAgo int      `json:"days_ago,omitempty" jsonschema:"Number of days back to fetch (default 30)"`
+	After   string   `json:"after,omitempty"    jsonschema:"Start date (YYYY-MM-DD)"`
+	Before  string   `json:"before,omitempty"   jsonschema:"End date (YYYY-MM-DD)"`
+	Users   []string `json:"users,omitempty"    jsonschema:"Usernames or IDs whose activity to list instead of the authenticated user's"`
+	Project string   `json:"project,omitempty"  jsonschema:"Project ID or path whose activity to list; users and team filter it by author"`
+	Team    string   `json:"team,omitempty"     jsonschema:"Team whose activity to list: a configured team name or a group path"`
 }
 
 type ActivityListOutput struct {
@@ -638,6 +1025,16 @@ func (s *Server) ActivityListHandler(ctx context.Context, req *sdkmcp.CallToolRe
 		opts.Before = input.Before
 	}
 
+	opts.Users = input.Users
+	opts.ProjectID = input.Project
+	if input.Team != "" {
+		members, err := s.teamMembers(input.Team)
+		if err != nil {
+			return nil, ActivityListOutput{}, err
+		}
+		opts.Users = append(opts.Users, members...)
+	}
+
 	events, err := s.client.GetEvents(opts)
 	if err != nil {
 		return nil, ActivityListOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
@@ -654,18 +1051,44 @@ func (s *Server) ActivityListHandler(ctx context.Context, req *sdkmcp.CallToolRe
 			TargetID:    e.TargetID,
 			TargetIID:   e.TargetIID,
 			TargetTitle: e.TargetTitle,
+			Author:      e.AuthorUsername,
 		}
 	}
 
 	return nil, ActivityListOutput{Events: outputs}, nil
 }
 
+// teamMembers returns the usernames of a team configured by name, or of the
+// members of a group otherwise.
+func (s *Server) teamMembers(team string) ([]string, error) {
+	if t, ok := s.config.Teams[team]; ok {
+		return t.Usernames(), nil
+	}
+
+	// Unlike the CLI, never read a team file: it would let MCP clients read
+	// any local file through the usernames echoed back in errors
+	members, err := s.client.ListGroupMembers(team, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("%w: group %q has no members", ErrInvalidInput, team)
+	}
+	users := make([]string, len(members))
+	for i, m := range members {
+		users[i] = m.Username
+	}
+	return users, nil
+}
+
 // --- project-list ---
 
 type ProjectListInput struct {
 	Search     string `json:"search,omitempty"     jsonschema:"Search projects by name"`
 	Owned      bool   `json:"owned,omitempty"      jsonschema:"Only projects owned by me"`
 	Membership bool   `json:"membership,omitempty" jsonschema:"Only projects I am a member of"`
+	Group      string `json:"group,omitempty"      jsonschema:"Only projects of this group (ID or path)"`
+	Subgroups  bool   `json:"include_subgroups,omitempty" jsonschema:"With group, include projects of subgroups"`
 }
 
 type ProjectListOutput struct {
@@ -674,9 +1097,11 @@ type ProjectListOutput struct {
 
 func (s *Server) ProjectListHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input ProjectListInput) (*sdkmcp.CallToolResult, ProjectListOutput, error) {
 	opts := gitlab.ListProjectsOptions{
-		Search:     input.Search,
-		Owned:      input.Owned,
-		Membership: input.Membership,
+		Search:           input.Search,
+		Owned:            input.Owned,
+		Membership:       input.Membership,
+		GroupID:          input.Group,
+		IncludeSubgroups: input.Subgroups,
 	}
 
 	projects, err := s.client.ListProjects(opts)
@@ -703,6 +1128,7 @@ func (s *Server) ProjectListHandler(ctx context.Context, req *sdkmcp.CallToolReq
 type UserListInput struct {
 	Search  string `json:"search,omitempty"  jsonschema:"Search users by name or username"`
 	Project string `json:"project,omitempty" jsonschema:"Project ID or path to scope search to project members"`
+	Group   string `json:"group,omitempty"   jsonschema:"Group ID or path to scope search to group members (including inherited)"`
 }
 
 type UserListOutput struct {
@@ -713,9 +1139,14 @@ func (s *Server) UserListHandler(ctx context.Context, req *sdkmcp.CallToolReques
 	var users []gitlab.User
 	var err error
 
-	if input.Project != "" {
+	switch {
+	case input.Project != "" && input.Group != "":
+		return nil, UserListOutput{}, fmt.Errorf("%w: project and group are mutually exclusive", ErrInvalidInput)
+	case input.Project != "":
 		users, err = s.client.ListProjectMembers(input.Project, input.Search)
-	} else {
+	case input.Group != "":
+		users, err = s.client.ListGroupMembers(input.Group, input.Search)
+	default:
 		users, err = s.client.ListUsers(gitlab.ListUsersOptions{Search: input.Search})
 	}
 
@@ -765,6 +1196,291 @@ func (s *Server) LabelListHandler(ctx context.Context, req *sdkmcp.CallToolReque
 	return nil, LabelListOutput{Labels: outputs}, nil
 }
 
+// --- issue-list ---
+
+type IssueListInput struct {
+	Project string `json:"project,omitempty" jsonschema:"Project ID or path (omit to list across all accessible projects)"`
+	State   string `json:"state,omitempty"   jsonschema:"Issue state: opened (default), closed, or all"`
+	Mine    bool   `json:"mine,omitempty"    jsonschema:"Only issues assigned to me"`
+	Labels  string `json:"labels,omitempty"  jsonschema:"Filter by labels (comma-separated)"`
+	Search  string `json:"search,omitempty"  jsonschema:"Search in title and description"`
+}
+
+type IssueListOutput struct {
+	Issues []IssueSummary `json:"issues"`
+}
+
+func (s *Server) IssueListHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input IssueListInput) (*sdkmcp.CallToolResult, IssueListOutput, error) {
+	if input.State != "" && input.State != "opened" && input.State != "closed" && input.State != "all" {
+		return nil, IssueListOutput{}, fmt.Errorf("%w: state must be \"opened\", \"closed\" or \"all\"", ErrInvalidInput)
+	}
+
+	opts := gitlab.ListIssuesOptions{
+		ProjectID: input.Project,
+		State:     input.State,
+		Labels:    input.Labels,
+		Search:    input.Search,
+	}
+	if input.Mine {
+		opts.Scope = "assigned_to_me"
+	}
+
+	issues, err := s.client.ListIssues(opts)
+	if err != nil {
+		return nil, IssueListOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	return nil, IssueListOutput{Issues: toIssueSummaries(issues)}, nil
+}
+
+// --- issue-show ---
+
+type IssueShowInput struct {
+	Project  string `json:"project"   jsonschema:"Project ID or path,required"`
+	IssueIID int    `json:"issue_iid" jsonschema:"Issue IID,required"`
+}
+
+type IssueShowOutput struct {
+	Issue                IssueSummary      `json:"issue"`
+	Description          string            `json:"description,omitempty"`
+	RelatedMergeRequests []RelatedMROutput `json:"related_merge_requests"`
+	Warnings             []string          `json:"warnings,omitempty"`
+}
+
+func (s *Server) IssueShowHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input IssueShowInput) (*sdkmcp.CallToolResult, IssueShowOutput, error) {
+	if input.Project == "" || input.IssueIID == 0 {
+		return nil, IssueShowOutput{}, fmt.Errorf("%w: project and issue_iid are required", ErrMissingParam)
+	}
+
+	issue, err := s.client.GetIssue(input.Project, input.IssueIID)
+	if err != nil {
+		return nil, IssueShowOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	output := IssueShowOutput{
+		Issue:                toIssueSummary(*issue),
+		Description:          issue.Description,
+		RelatedMergeRequests: []RelatedMROutput{},
+	}
+
+	related, err := s.client.GetIssueRelatedMRs(input.Project, input.IssueIID)
+	if err != nil {
+		output.Warnings = append(output.Warnings, fmt.Sprintf("failed to fetch related merge requests: %v", err))
+		return nil, output, nil
+	}
+
+	closing := make(map[int]bool)
+	closedBy, err := s.client.GetIssueClosedBy(input.Project, input.IssueIID)
+	if err != nil {
+		output.Warnings = append(output.Warnings, fmt.Sprintf("failed to fetch closing merge requests: %v", err))
+	}
+	for _, mr := range closedBy {
+		closing[mr.ID] = true
+	}
+
+	for _, mr := range related {
+		output.RelatedMergeRequests = append(output.RelatedMergeRequests, RelatedMROutput{
+			MRSummary:   toMRSummary(mr),
+			ClosesIssue: closing[mr.ID],
+		})
+	}
+
+	return nil, output, nil
+}
+
+// --- milestone-list ---
+
+type MilestoneListInput struct {
+	Project string `json:"project,omitempty" jsonschema:"Project ID or path (one of project or group is required)"`
+	Group   string `json:"group,omitempty"   jsonschema:"Group ID or path"`
+	State   string `json:"state,omitempty"   jsonschema:"Milestone state: active (default), closed, or all"`
+	Search  string `json:"search,omitempty"  jsonschema:"Filter by title or description"`
+}
+
+type MilestoneListOutput struct {
+	Milestones []MilestoneOutput `json:"milestones"`
+}
+
+func (s *Server) MilestoneListHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input MilestoneListInput) (*sdkmcp.CallToolResult, MilestoneListOutput, error) {
+	if (input.Project == "") == (input.Group == "") {
+		return nil, MilestoneListOutput{}, fmt.Errorf("%w: exactly one of project or group is required", ErrMissingParam)
+	}
+
+	state := input.State
+	if state == "" {
+		state = "active"
+	}
+	if state != "active" && state != "closed" && state != "all" {
+		return nil, MilestoneListOutput{}, fmt.Errorf("%w: state must be \"active\", \"closed\" or \"all\"", ErrInvalidInput)
+	}
+
+	milestones, err := s.client.ListMilestones(gitlab.ListMilestonesOptions{
+		ProjectID: input.Project,
+		GroupID:   input.Group,
+		State:     state,
+		Search:    input.Search,
+	})
+	if err != nil {
+		return nil, MilestoneListOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	outputs := make([]MilestoneOutput, len(milestones))
+	for i, m := range milestones {
+		outputs[i] = MilestoneOutput{
+			ID:        m.ID,
+			Title:     m.Title,
+			State:     m.State,
+			StartDate: m.StartDate,
+			DueDate:   m.DueDate,
+			Expired:   m.Expired,
+			GroupID:   m.GroupID,
+			WebURL:    m.WebURL,
+		}
+	}
+
+	return nil, MilestoneListOutput{Milestones: outputs}, nil
+}
+
+// --- issue-create ---
+
+type IssueCreateInput struct {
+	Project     string   `json:"project"                jsonschema:"Project ID or path (e.g. group/repo),required"`
+	Title       string   `json:"title"                  jsonschema:"Issue title,required"`
+	Description string   `json:"description,omitempty"  jsonschema:"Issue description"`
+	Labels      []string `json:"labels,omitempty"       jsonschema:"Labels to apply"`
+	AssigneeIDs []int    `json:"assignee_ids,omitempty" jsonschema:"User IDs to assign"`
+	DueDate     string   `json:"due_date,omitempty"     jsonschema:"Due date (YYYY-MM-DD)"`
+	DryRun      bool     `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
+}
+
+type IssueCreateOutput struct {
+	Issue  IssueSummary  `json:"issue"`
+	DryRun *DryRunOutput `json:"dry_run,omitempty"`
+}
+
+func (s *Server) IssueCreateHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input IssueCreateInput) (*sdkmcp.CallToolResult, IssueCreateOutput, error) {
+	if input.Project == "" || input.Title == "" {
+		return nil, IssueCreateOutput{}, fmt.Errorf("%w: project and title are required", ErrMissingParam)
+	}
+
+	opts := gitlab.CreateIssueOptions{
+		Title:       input.Title,
+		Description: input.Description,
+		Labels:      input.Labels,
+		AssigneeIDs: input.AssigneeIDs,
+		DueDate:     input.DueDate,
+	}
+
+	if input.DryRun {
+		return nil, IssueCreateOutput{
+			DryRun: &DryRunOutput{Requests: []DryRunRequest{{Action: "create issue", Body: opts.RequestBody()}}},
+		}, nil
+	}
+
+	issue, err := s.client.CreateIssue(input.Project, opts)
+	if err != nil {
+		return nil, IssueCreateOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	return nil, IssueCreateOutput{Issue: toIssueSummary(*issue)}, nil
+}
+
+// --- issue-update ---
+
+type IssueUpdateInput struct {
+	Project      string   `json:"project"                 jsonschema:"Project ID or path,required"`
+	IssueIID     int      `json:"issue_iid"               jsonschema:"Issue IID,required"`
+	Title        *string  `json:"title,omitempty"         jsonschema:"New issue title"`
+	Description  *string  `json:"description,omitempty"   jsonschema:"New issue description"`
+	AddLabels    []string `json:"add_labels,omitempty"    jsonschema:"Labels to add"`
+	RemoveLabels []string `json:"remove_labels,omitempty" jsonschema:"Labels to remove"`
+	AssigneeIDs  []int    `json:"assignee_ids,omitempty"  jsonschema:"Replace all assignees by user ID"`
+	DueDate      *string  `json:"due_date,omitempty"      jsonschema:"Due date (YYYY-MM-DD, empty to clear)"`
+	StateEvent   *string  `json:"state_event,omitempty"   jsonschema:"State transition: close or reopen"`
+	DryRun       bool     `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
+}
+
+type IssueUpdateOutput struct {
+	Issue  IssueSummary  `json:"issue"`
+	DryRun *DryRunOutput `json:"dry_run,omitempty"`
+}
+
+func (s *Server) IssueUpdateHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input IssueUpdateInput) (*sdkmcp.CallToolResult, IssueUpdateOutput, error) {
+	if input.Project == "" || input.IssueIID == 0 {
+		return nil, IssueUpdateOutput{}, fmt.Errorf("%w: project and issue_iid are required", ErrMissingParam)
+	}
+
+	if input.StateEvent != nil && *input.StateEvent != "close" && *input.StateEvent != "reopen" {
+		return nil, IssueUpdateOutput{}, fmt.Errorf("%w: state_event must be \"close\" or \"reopen\"", ErrInvalidInput)
+	}
+
+	opts := gitlab.UpdateIssueOptions{
+		Title:        input.Title,
+		Description:  input.Description,
+		AddLabels:    input.AddLabels,
+		RemoveLabels: input.RemoveLabels,
+		AssigneeIDs:  input.AssigneeIDs,
+		DueDate:      input.DueDate,
+		StateEvent:   input.StateEvent,
+	}
+
+	if input.DryRun {
+		issue, err := s.client.GetIssue(input.Project, input.IssueIID)
+		if err != nil {
+			return nil, IssueUpdateOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+		}
+		return nil, IssueUpdateOutput{
+			Issue:  toIssueSummary(*issue),
+			DryRun: &DryRunOutput{Requests: []DryRunRequest{{Action: "update issue", Body: opts.RequestBody()}}},
+		}, nil
+	}
+
+	issue, err := s.client.UpdateIssue(input.Project, input.IssueIID, opts)
+	if err != nil {
+		return nil, IssueUpdateOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	return nil, IssueUpdateOutput{Issue: toIssueSummary(*issue)}, nil
+}
+
+// --- issue-comment ---
+
+type IssueCommentInput struct {
+	Project  string `json:"project"   jsonschema:"Project ID or path,required"`
+	IssueIID int    `json:"issue_iid" jsonschema:"Issue IID,required"`
+	Body     string `json:"body"      jsonschema:"Comment text (Markdown),required"`
+	DryRun   bool   `json:"dry_run,omitempty" jsonschema:"Resolve and return the requests that would be sent without changing anything"`
+}
+
+type IssueCommentOutput struct {
+	Note   NoteOutput    `json:"note"`
+	DryRun *DryRunOutput `json:"dry_run,omitempty"`
+}
+
+func (s *Server) IssueCommentHandler(ctx context.Context, req *sdkmcp.CallToolRequest, input IssueCommentInput) (*sdkmcp.CallToolResult, IssueCommentOutput, error) {
+	if input.Project == "" || input.IssueIID == 0 || strings.TrimSpace(input.Body) == "" {
+		return nil, IssueCommentOutput{}, fmt.Errorf("%w: project, issue_iid and body are required", ErrMissingParam)
+	}
+
+	if input.DryRun {
+		return nil, IssueCommentOutput{
+			DryRun: &DryRunOutput{Requests: []DryRunRequest{{Action: "comment on issue", Body: map[string]interface{}{"body": input.Body}}}},
+		}, nil
+	}
+
+	note, err := s.client.CreateIssueNote(input.Project, input.IssueIID, input.Body)
+	if err != nil {
+		return nil, IssueCommentOutput{}, fmt.Errorf("%w: %v", ErrGitLabAPI, err)
+	}
+
+	return nil, IssueCommentOutput{Note: NoteOutput{
+		ID:        note.ID,
+		Author:    note.Author.Username,
+		Body:      note.Body,
+		CreatedAt: note.CreatedAt,
+	}}, nil
+}
+
 // --- config-show ---
 
 type ConfigShowInput struct{}
@@ -796,6 +1512,14 @@ func (s *Server) ConfigShowHandler(ctx context.Context, req *sdkmcp.CallToolRequ
 
 // --- Helper functions ---
 
+// previewMRUpdate describes an update of mr with opts as a single request.
+func previewMRUpdate(mr *gitlab.MergeRequest, action string, opts gitlab.UpdateMROptions) *DryRunOutput {
+	return &DryRunOutput{
+		Requests: []DryRunRequest{{Action: action, Body: opts.RequestBody()}},
+		Changes:  gitlab.PreviewMRUpdate(mr, opts),
+	}
+}
+
 func toMRSummary(mr gitlab.MergeRequest) MRSummary {
 	return MRSummary{
 		ID:           mr.ID,
@@ -819,6 +1543,33 @@ func toUserSummaries(users []gitlab.User) []UserSummary {
 	return summaries
 }
 
+func toIssueSummary(issue gitlab.Issue) IssueSummary {
+	assignees := make([]string, len(issue.Assignees))
+	for i, u := range issue.Assignees {
+		assignees[i] = u.Username
+	}
+	return IssueSummary{
+		ID:        issue.ID,
+		IID:       issue.IID,
+		ProjectID: issue.ProjectID,
+		Title:     issue.Title,
+		State:     issue.State,
+		Author:    issue.Author.Username,
+		Assignees: assignees,
+		Labels:    issue.Labels,
+		DueDate:   issue.DueDate,
+		WebURL:    issue.WebURL,
+	}
+}
+
+func toIssueSummaries(issues []gitlab.Issue) []IssueSummary {
+	summaries := make([]IssueSummary, len(issues))
+	for i, issue := range issues {
+		summaries[i] = toIssueSummary(issue)
+	}
+	return summaries
+}
+
 func toDiscussionOutputs(discussions []gitlab.Discussion) []DiscussionOutput {
 	outputs := make([]DiscussionOutput, len(discussions))
 	for i, d := range discussions {
@@ -848,8 +1599,10 @@ func toApprovalOutput(state *gitlab.ApprovalState) *ApprovalOutput {
 		approvers[i] = a.User.Username
 	}
 	return &ApprovalOutput{
-		Approved:  state.Approved,
-		Approvers: approvers,
+		Approved:          state.Approved,
+		ApprovalsRequired: state.ApprovalsRequired,
+		ApprovalsLeft:     state.ApprovalsLeft,
+		Approvers:         approvers,
 	}
 }
 
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -64,6 +67,30 @@ func TestMRListHandler(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name:  "filter group",
+			input: MRListInput{Group: "team"},
+			setupClient: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					listMRsFunc: func(opts gitlab.ListMROptions) ([]gitlab.MergeRequest, error) {
+						if opts.GroupID != "team" {
+							t.Errorf("expected group team, got %q", opts.GroupID)
+						}
+						return []gitlab.MergeRequest{{ID: 1, IID: 10, Title: "Team MR"}}, nil
+					},
+				}
+			},
+			wantCount: 1,
+		},
+		{
+			name:  "project and group",
+			input: MRListInput{ProjectID: 1, Group: "team"},
+			setupClient: func() *mockGitLabClient {
+				return &mockGitLabClient{}
+			},
+			wantErr:     true,
+			errContains: "mutually exclusive",
+		},
 		{
 			name:  "API error",
 			input: MRListInput{},
@@ -294,6 +321,28 @@ func TestMRCreateHandler(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "create with closing issues",
+			input: MRCreateInput{
+				Project:      "group/repo",
+				SourceBranch: "feature",
+				TargetBranch: "main",
+				Title:        "Fix",
+				Description:  "Body",
+				Closes:       []int{12, 13},
+			},
+			setupClient: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					createMRFunc: func(projectID string, opts gitlab.CreateMROptions) (*gitlab.MergeRequest, error) {
+						want := "Body\n\nCloses #12\nCloses #13"
+						if opts.Description != want {
+							t.Errorf("description = %q, want %q", opts.Description, want)
+						}
+						return &gitlab.MergeRequest{ID: 1, IID: 10, ProjectID: 42, Title: opts.Title}, nil
+					},
+				}
+			},
+		},
 		{
 			name:  "missing required params",
 			input: MRCreateInput{Project: "group/repo"},
@@ -442,6 +491,56 @@ func TestMRReviewerHandler(t *testing.T) {
 	}
 }
 
+func TestMRReviewerHandlerSuggest(t *testing.T) {
+	users := map[string]gitlab.User{
+		"alice": {ID: 1, Username: "alice"},
+		"bob":   {ID: 2, Username: "bob"},
+		"carol": {ID: 3, Username: "carol"},
+	}
+	var updated []int
+	mock := &mockGitLabClient{
+		getMRFunc: func(_, _ int) (*gitlab.MergeRequest, error) {
+			return &gitlab.MergeRequest{ID: 100, IID: 10, ProjectID: 1, TargetBranch: "main", Author: users["alice"]}, nil
+		},
+		getRawFileFunc: func(_, path, ref string) ([]byte, error) {
+			if path != "CODEOWNERS" || ref != "main" {
+				return nil, errors.New("not found")
+			}
+			return []byte("* @alice @bob @carol\n"), nil
+		},
+		getMRDiffsFunc: func(_, _ int) ([]gitlab.MRDiff, error) {
+			return []gitlab.MRDiff{{OldPath: "main.go", NewPath: "main.go"}}, nil
+		},
+		getUserByUsernameFunc: func(username string) (*gitlab.User, error) {
+			u := users[username]
+			return &u, nil
+		},
+		listMRsFunc: func(opts gitlab.ListMROptions) ([]gitlab.MergeRequest, error) {
+			if opts.ReviewerID == 2 {
+				return []gitlab.MergeRequest{{ID: 1}, {ID: 2}}, nil
+			}
+			return nil, nil
+		},
+		updateMRReviewersFunc: func(_, _ int, ids []int) (*gitlab.MergeRequest, error) {
+			updated = ids
+			return &gitlab.MergeRequest{}, nil
+		},
+	}
+
+	s := testServer(mock)
+	_, output, err := s.MRReviewerHandler(context.Background(), nil, MRReviewerInput{ProjectID: 1, MRIID: 10, Auto: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(output.Suggestions) != 2 || output.Suggestions[0].Username != "carol" || output.Suggestions[1].Load != 2 {
+		t.Errorf("suggestions = %+v", output.Suggestions)
+	}
+	if !slices.Equal(updated, []int{3}) {
+		t.Errorf("reviewers set to %v, want [3]", updated)
+	}
+}
+
 func TestMRAssigneeHandler(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -611,6 +710,45 @@ func TestMRUpdateHandler(t *testing.T) {
 			},
 			wantTitle: "Updated",
 		},
+		{
+			name: "milestone by title",
+			input: MRUpdateInput{
+				ProjectID: 1, MRIID: 10,
+				Milestone: strPtr("v1.0"),
+			},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					resolveMilestoneIDFunc: func(projectID string, ref string) (int, error) {
+						if projectID != "1" || ref != "v1.0" {
+							t.Errorf("resolve(%q, %q), want (1, v1.0)", projectID, ref)
+						}
+						return 42, nil
+					},
+					updateMRFunc: func(pid, iid int, opts gitlab.UpdateMROptions) (*gitlab.MergeRequest, error) {
+						if opts.MilestoneID == nil || *opts.MilestoneID != 42 {
+							t.Errorf("milestone_id = %v, want 42", opts.MilestoneID)
+						}
+						return &gitlab.MergeRequest{
+							ID: 100, IID: 10, ProjectID: 1, Title: "Same",
+							Author: gitlab.User{Username: "dev"},
+						}, nil
+					},
+				}
+			},
+			wantTitle: "Same",
+		},
+		{
+			name: "milestone and milestone_id together",
+			input: MRUpdateInput{
+				ProjectID: 1, MRIID: 10,
+				Milestone: strPtr("v1.0"), MilestoneID: intPtr(3),
+			},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{}
+			},
+			wantErr:     true,
+			errContains: "mutually exclusive",
+		},
 		{
 			name:  "missing params",
 			input: MRUpdateInput{},
@@ -681,6 +819,7 @@ func TestMRUpdateHandler(t *testing.T) {
 
 func strPtr(s string) *string { return &s }
 func boolPtr(b bool) *bool    { return &b }
+func intPtr(i int) *int       { return &i }
 
 func TestMRAutoMergeHandler(t *testing.T) {
 	tests := []struct {
@@ -732,6 +871,190 @@ func TestMRAutoMergeHandler(t *testing.T) {
 	}
 }
 
+func TestMRApproveHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        MRApproveInput
+		setup        func() *mockGitLabClient
+		wantApproved bool
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:  "approve pinned to sha",
+			input: MRApproveInput{ProjectID: 1, MRIID: 10, SHA: "abc123"},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					approveMRFunc: func(projectID, iid int, sha string) (*gitlab.ApprovalState, error) {
+						if sha != "abc123" {
+							t.Errorf("sha = %q, want abc123", sha)
+						}
+						return &gitlab.ApprovalState{
+							Approved:  true,
+							Approvers: []gitlab.ApprovalUser{{User: gitlab.User{Username: "me"}}},
+						}, nil
+					},
+				}
+			},
+			wantApproved: true,
+		},
+		{
+			name:  "unapprove returns refreshed state",
+			input: MRApproveInput{ProjectID: 1, MRIID: 10, Unapprove: true},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					approveMRFunc: func(projectID, iid int, sha string) (*gitlab.ApprovalState, error) {
+						t.Error("ApproveMR should not be called when unapproving")
+						return nil, nil
+					},
+					getMRApprovalsFunc: func(projectID, iid int) (*gitlab.ApprovalState, error) {
+						return &gitlab.ApprovalState{Approved: false, ApprovalsRequired: 1, ApprovalsLeft: 1}, nil
+					},
+				}
+			},
+			wantApproved: false,
+		},
+		{
+			name:  "sha mismatch surfaces API error",
+			input: MRApproveInput{ProjectID: 1, MRIID: 10, SHA: "stale"},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					approveMRFunc: func(projectID, iid int, sha string) (*gitlab.ApprovalState, error) {
+						return nil, errors.New("API error (status 409): SHA does not match HEAD of source branch")
+					},
+				}
+			},
+			wantErr:     true,
+			errContains: "SHA does not match",
+		},
+		{
+			name:    "missing params",
+			input:   MRApproveInput{},
+			setup:   func() *mockGitLabClient { return &mockGitLabClient{} },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(tt.setup())
+			_, output, err := s.MRApproveHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+			if output.Approvals.Approved != tt.wantApproved {
+				t.Errorf("approved = %v, want %v", output.Approvals.Approved, tt.wantApproved)
+			}
+		})
+	}
+}
+
+func TestMRDiffHandler(t *testing.T) {
+	bigHunk := "@@ -1,2 +1,2 @@\n-" + strings.Repeat("a", 40) + "\n+" + strings.Repeat("b", 40) + "\n"
+	diffs := []gitlab.MRDiff{
+		{OldPath: "main.go", NewPath: "main.go", Diff: bigHunk + bigHunk},
+		{OldPath: "util.go", NewPath: "util.go", Diff: "@@ -1 +1 @@\n-x\n+y\n"},
+		{OldPath: "vendor/lib.go", NewPath: "vendor/lib.go", Diff: "@@ -1 +1 @@\n-x\n+y\n"},
+		{OldPath: "huge.json", NewPath: "huge.json", TooLarge: true},
+	}
+
+	tests := []struct {
+		name           string
+		input          MRDiffInput
+		wantFiles      int
+		wantChunks     int
+		wantNextOffset int
+		wantErr        bool
+	}{
+		{
+			name:       "all files fit",
+			input:      MRDiffInput{ProjectID: 1, MRIID: 10},
+			wantFiles:  4,
+			wantChunks: 4,
+		},
+		{
+			name:       "exclude glob",
+			input:      MRDiffInput{ProjectID: 1, MRIID: 10, Exclude: []string{"vendor/**"}},
+			wantFiles:  3,
+			wantChunks: 3,
+		},
+		{
+			name:           "large file split into hunk chunks and paged",
+			input:          MRDiffInput{ProjectID: 1, MRIID: 10, Paths: []string{"main.go"}, MaxChunkBytes: 100, MaxTotalBytes: 100},
+			wantFiles:      1,
+			wantChunks:     1,
+			wantNextOffset: 1,
+		},
+		{
+			name:       "second page",
+			input:      MRDiffInput{ProjectID: 1, MRIID: 10, Paths: []string{"main.go"}, MaxChunkBytes: 100, MaxTotalBytes: 100, Offset: 1},
+			wantFiles:  1,
+			wantChunks: 1,
+		},
+		{
+			name:    "missing params",
+			input:   MRDiffInput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				getMRDiffsFunc: func(projectID, iid int) ([]gitlab.MRDiff, error) {
+					return diffs, nil
+				},
+			})
+			_, output, err := s.MRDiffHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if output.TotalFiles != tt.wantFiles {
+				t.Errorf("total_files = %d, want %d", output.TotalFiles, tt.wantFiles)
+			}
+			if len(output.Files) != tt.wantChunks {
+				t.Errorf("returned %d chunks, want %d", len(output.Files), tt.wantChunks)
+			}
+			if output.NextOffset != tt.wantNextOffset {
+				t.Errorf("next_offset = %d, want %d", output.NextOffset, tt.wantNextOffset)
+			}
+		})
+	}
+}
+
+func TestSplitDiffChunks(t *testing.T) {
+	hunk := "@@ -1 +1 @@\n-old\n+new\n"
+	diff := hunk + hunk + hunk
+
+	if got := splitDiffChunks(diff, len(diff)); len(got) != 1 {
+		t.Errorf("diff within limit should be one chunk, got %d", len(got))
+	}
+
+	chunks := splitDiffChunks(diff, len(hunk)*2)
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(chunks))
+	}
+	if strings.Join(chunks, "") != diff {
+		t.Error("chunks do not reassemble to the original diff")
+	}
+	for i, c := range chunks {
+		if !strings.HasPrefix(c, "@@") {
+			t.Errorf("chunk %d does not start at a hunk boundary: %q", i, c)
+		}
+	}
+}
+
 func TestMRResolveHandler(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -976,6 +1299,27 @@ func TestActivityListHandler(t *testing.T) {
 			},
 			wantCount: 2,
 		},
+		{
+			name:  "team group with project",
+			input: ActivityListInput{Team: "acme/backend", Project: "acme/api"},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					listGroupMembersFunc: func(groupID string, search string) ([]gitlab.User, error) {
+						if groupID != "acme/backend" {
+							t.Errorf("expected group acme/backend, got %s", groupID)
+						}
+						return []gitlab.User{{Username: "alice"}, {Username: "bob"}}, nil
+					},
+					getEventsFunc: func(opts gitlab.ListEventsOptions) ([]gitlab.Event, error) {
+						if opts.ProjectID != "acme/api" || strings.Join(opts.Users, ",") != "alice,bob" {
+							t.Errorf("unexpected selectors: %+v", opts)
+						}
+						return []gitlab.Event{{ID: 1, AuthorUsername: "alice"}}, nil
+					},
+				}
+			},
+			wantCount: 1,
+		},
 		{
 			name:  "API error",
 			input: ActivityListInput{},
@@ -1008,6 +1352,32 @@ func TestActivityListHandler(t *testing.T) {
 	}
 }
 
+func TestActivityListHandlerTeamFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "team.txt")
+	if err := os.WriteFile(path, []byte("secret-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := testServer(&mockGitLabClient{
+		listGroupMembersFunc: func(groupID string, search string) ([]gitlab.User, error) {
+			return nil, errors.New("404 Group Not Found")
+		},
+		getEventsFunc: func(opts gitlab.ListEventsOptions) ([]gitlab.Event, error) {
+			t.Errorf("events fetched for %v", opts.Users)
+			return nil, nil
+		},
+	})
+
+	// A local file is looked up as a group, never read
+	_, _, err := s.ActivityListHandler(context.Background(), nil, ActivityListInput{Team: path})
+	if !errors.Is(err, ErrGitLabAPI) {
+		t.Fatalf("expected a GitLab API error, got %v", err)
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks the file: %v", err)
+	}
+}
+
 func TestProjectListHandler(t *testing.T) {
 	s := testServer(&mockGitLabClient{
 		listProjectsFunc: func(opts gitlab.ListProjectsOptions) ([]gitlab.Project, error) {
@@ -1058,6 +1428,26 @@ func TestUserListHandler(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "group scoped",
+			input: UserListInput{Group: "team"},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					listGroupMembersFunc: func(groupID string, search string) ([]gitlab.User, error) {
+						if groupID != "team" {
+							t.Errorf("groupID = %s, want team", groupID)
+						}
+						return []gitlab.User{{ID: 1, Username: "dev"}}, nil
+					},
+				}
+			},
+		},
+		{
+			name:    "project and group",
+			input:   UserListInput{Project: "42", Group: "team"},
+			setup:   func() *mockGitLabClient { return &mockGitLabClient{} },
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1110,6 +1500,364 @@ func TestLabelListHandler(t *testing.T) {
 	}
 }
 
+func TestIssueListHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      IssueListInput
+		wantScope  string
+		wantIssues int
+		wantErr    bool
+	}{
+		{
+			name:       "project issues",
+			input:      IssueListInput{Project: "group/repo", State: "all"},
+			wantIssues: 2,
+		},
+		{
+			name:       "mine across projects",
+			input:      IssueListInput{Mine: true},
+			wantScope:  "assigned_to_me",
+			wantIssues: 2,
+		},
+		{
+			name:    "invalid state",
+			input:   IssueListInput{State: "done"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				listIssuesFunc: func(opts gitlab.ListIssuesOptions) ([]gitlab.Issue, error) {
+					if opts.Scope != tt.wantScope {
+						t.Errorf("scope = %q, want %q", opts.Scope, tt.wantScope)
+					}
+					if opts.ProjectID != tt.input.Project {
+						t.Errorf("project = %q, want %q", opts.ProjectID, tt.input.Project)
+					}
+					return []gitlab.Issue{
+						{ID: 1, IID: 5, Title: "First", Assignees: []gitlab.User{{Username: "alice"}}},
+						{ID: 2, IID: 6, Title: "Second"},
+					}, nil
+				},
+			})
+			_, output, err := s.IssueListHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(output.Issues) != tt.wantIssues {
+				t.Errorf("got %d issues, want %d", len(output.Issues), tt.wantIssues)
+			}
+		})
+	}
+}
+
+func TestMilestoneListHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     MilestoneListInput
+		wantState string
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:      "project milestones default to active",
+			input:     MilestoneListInput{Project: "group/repo"},
+			wantState: "active",
+			wantCount: 2,
+		},
+		{
+			name:      "group milestones all states",
+			input:     MilestoneListInput{Group: "group", State: "all"},
+			wantState: "all",
+			wantCount: 2,
+		},
+		{
+			name:    "neither project nor group",
+			input:   MilestoneListInput{},
+			wantErr: true,
+		},
+		{
+			name:    "both project and group",
+			input:   MilestoneListInput{Project: "group/repo", Group: "group"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid state",
+			input:   MilestoneListInput{Project: "group/repo", State: "expired"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				listMilestonesFunc: func(opts gitlab.ListMilestonesOptions) ([]gitlab.Milestone, error) {
+					if opts.State != tt.wantState {
+						t.Errorf("state = %q, want %q", opts.State, tt.wantState)
+					}
+					if opts.ProjectID != tt.input.Project || opts.GroupID != tt.input.Group {
+						t.Errorf("project/group = %q/%q, want %q/%q", opts.ProjectID, opts.GroupID, tt.input.Project, tt.input.Group)
+					}
+					return []gitlab.Milestone{
+						{ID: 1, Title: "v1.0", State: "active", DueDate: "2026-11-01"},
+						{ID: 2, Title: "Q4", State: "active", GroupID: 7},
+					}, nil
+				},
+			})
+			_, output, err := s.MilestoneListHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(output.Milestones) != tt.wantCount {
+				t.Errorf("got %d milestones, want %d", len(output.Milestones), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestIssueShowHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        IssueShowInput
+		setup        func() *mockGitLabClient
+		wantRelated  int
+		wantClosing  int
+		wantWarnings int
+		wantErr      bool
+	}{
+		{
+			name:  "related MRs with closing flag",
+			input: IssueShowInput{Project: "42", IssueIID: 5},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					getIssueFunc: func(projectID string, iid int) (*gitlab.Issue, error) {
+						return &gitlab.Issue{ID: 1, IID: iid, ProjectID: 42, Title: "Bug"}, nil
+					},
+					getIssueRelatedMRsFunc: func(projectID string, iid int) ([]gitlab.MergeRequest, error) {
+						return []gitlab.MergeRequest{{ID: 100, IID: 1}, {ID: 101, IID: 2}}, nil
+					},
+					getIssueClosedByFunc: func(projectID string, iid int) ([]gitlab.MergeRequest, error) {
+						return []gitlab.MergeRequest{{ID: 101, IID: 2}}, nil
+					},
+				}
+			},
+			wantRelated: 2,
+			wantClosing: 1,
+		},
+		{
+			name:  "related MRs failure becomes warning",
+			input: IssueShowInput{Project: "42", IssueIID: 5},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					getIssueFunc: func(projectID string, iid int) (*gitlab.Issue, error) {
+						return &gitlab.Issue{ID: 1, IID: iid, ProjectID: 42}, nil
+					},
+					getIssueRelatedMRsFunc: func(projectID string, iid int) ([]gitlab.MergeRequest, error) {
+						return nil, errors.New("forbidden")
+					},
+				}
+			},
+			wantWarnings: 1,
+		},
+		{
+			name:  "issue not found",
+			input: IssueShowInput{Project: "42", IssueIID: 5},
+			setup: func() *mockGitLabClient {
+				return &mockGitLabClient{
+					getIssueFunc: func(projectID string, iid int) (*gitlab.Issue, error) {
+						return nil, errors.New("404 Not found")
+					},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing params",
+			input:   IssueShowInput{Project: "42"},
+			setup:   func() *mockGitLabClient { return &mockGitLabClient{} },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(tt.setup())
+			_, output, err := s.IssueShowHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(output.RelatedMergeRequests) != tt.wantRelated {
+				t.Errorf("got %d related MRs, want %d", len(output.RelatedMergeRequests), tt.wantRelated)
+			}
+			closing := 0
+			for _, mr := range output.RelatedMergeRequests {
+				if mr.ClosesIssue {
+					closing++
+				}
+			}
+			if closing != tt.wantClosing {
+				t.Errorf("got %d closing MRs, want %d", closing, tt.wantClosing)
+			}
+			if len(output.Warnings) != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", len(output.Warnings), tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestIssueCreateHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   IssueCreateInput
+		wantErr bool
+	}{
+		{
+			name:  "create issue",
+			input: IssueCreateInput{Project: "group/repo", Title: "New bug", Labels: []string{"bug"}},
+		},
+		{
+			name:    "missing title",
+			input:   IssueCreateInput{Project: "group/repo"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				createIssueFunc: func(projectID string, opts gitlab.CreateIssueOptions) (*gitlab.Issue, error) {
+					if projectID != "group/repo" {
+						t.Errorf("projectID = %q, want group/repo", projectID)
+					}
+					return &gitlab.Issue{ID: 1, IID: 7, Title: opts.Title, Labels: opts.Labels}, nil
+				},
+			})
+			_, output, err := s.IssueCreateHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if output.Issue.IID != 7 || output.Issue.Title != tt.input.Title {
+				t.Errorf("issue = %+v, want IID 7 with title %q", output.Issue, tt.input.Title)
+			}
+		})
+	}
+}
+
+func TestIssueUpdateHandler(t *testing.T) {
+	closeEvent := "close"
+	badEvent := "delete"
+
+	tests := []struct {
+		name      string
+		input     IssueUpdateInput
+		wantState string
+		wantErr   bool
+	}{
+		{
+			name:      "close issue",
+			input:     IssueUpdateInput{Project: "42", IssueIID: 5, StateEvent: &closeEvent},
+			wantState: "closed",
+		},
+		{
+			name:      "add labels",
+			input:     IssueUpdateInput{Project: "42", IssueIID: 5, AddLabels: []string{"doing"}},
+			wantState: "opened",
+		},
+		{
+			name:    "invalid state event",
+			input:   IssueUpdateInput{Project: "42", IssueIID: 5, StateEvent: &badEvent},
+			wantErr: true,
+		},
+		{
+			name:    "missing params",
+			input:   IssueUpdateInput{Project: "42"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				updateIssueFunc: func(projectID string, iid int, opts gitlab.UpdateIssueOptions) (*gitlab.Issue, error) {
+					state := "opened"
+					if opts.StateEvent != nil && *opts.StateEvent == "close" {
+						state = "closed"
+					}
+					return &gitlab.Issue{ID: 1, IID: iid, State: state}, nil
+				},
+			})
+			_, output, err := s.IssueUpdateHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if output.Issue.State != tt.wantState {
+				t.Errorf("state = %q, want %q", output.Issue.State, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestIssueCommentHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   IssueCommentInput
+		wantErr bool
+	}{
+		{
+			name:  "comment",
+			input: IssueCommentInput{Project: "42", IssueIID: 5, Body: "Looking into it"},
+		},
+		{
+			name:    "empty body",
+			input:   IssueCommentInput{Project: "42", IssueIID: 5, Body: "  "},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(&mockGitLabClient{
+				createIssueNoteFunc: func(projectID string, iid int, body string) (*gitlab.Note, error) {
+					return &gitlab.Note{ID: 99, Body: body, Author: gitlab.User{Username: "me"}}, nil
+				},
+			})
+			_, output, err := s.IssueCommentHandler(context.Background(), nil, tt.input)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if output.Note.ID != 99 || output.Note.Body != tt.input.Body {
+				t.Errorf("note = %+v, want ID 99 with body %q", output.Note, tt.input.Body)
+			}
+		})
+	}
+}
+
 func TestConfigShowHandler(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1326,3 +2074,184 @@ func TestMRRebaseHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteToolsDryRun(t *testing.T) {
+	current := &gitlab.MergeRequest{
+		IID:                 10,
+		ProjectID:           1,
+		Title:               "Old title",
+		State:               "opened",
+		DetailedMergeStatus: "need_rebase",
+		Labels:              []string{"bug", "feature"},
+		Reviewers:           []gitlab.User{{ID: 5, Username: "bob"}},
+	}
+
+	// Every mutating call fails the test; reads return the current MR.
+	noWrites := func(t *testing.T) *mockGitLabClient {
+		fail := func(name string) { t.Errorf("%s called in dry-run mode", name) }
+		return &mockGitLabClient{
+			getMRFunc: func(_, _ int) (*gitlab.MergeRequest, error) { return current, nil },
+			getIssueFunc: func(_ string, iid int) (*gitlab.Issue, error) {
+				return &gitlab.Issue{IID: iid, Title: "Issue"}, nil
+			},
+			getMRApprovalsFunc: func(_, _ int) (*gitlab.ApprovalState, error) {
+				return &gitlab.ApprovalState{}, nil
+			},
+			createMRFunc: func(string, gitlab.CreateMROptions) (*gitlab.MergeRequest, error) {
+				fail("CreateMR")
+				return nil, nil
+			},
+			updateMRFunc: func(int, int, gitlab.UpdateMROptions) (*gitlab.MergeRequest, error) {
+				fail("UpdateMR")
+				return nil, nil
+			},
+			updateMRLabelsFunc: func(int, int, []string) (*gitlab.MergeRequest, error) {
+				fail("UpdateMRLabels")
+				return nil, nil
+			},
+			updateMRReviewersFunc: func(int, int, []int) (*gitlab.MergeRequest, error) {
+				fail("UpdateMRReviewers")
+				return nil, nil
+			},
+			rebaseMRFunc: func(int, int) error {
+				fail("RebaseMR")
+				return nil
+			},
+			mergeMRFunc: func(int, int) error {
+				fail("MergeMR")
+				return nil
+			},
+			approveMRFunc: func(int, int, string) (*gitlab.ApprovalState, error) {
+				fail("ApproveMR")
+				return nil, nil
+			},
+			createIssueFunc: func(string, gitlab.CreateIssueOptions) (*gitlab.Issue, error) {
+				fail("CreateIssue")
+				return nil, nil
+			},
+			updateIssueFunc: func(string, int, gitlab.UpdateIssueOptions) (*gitlab.Issue, error) {
+				fail("UpdateIssue")
+				return nil, nil
+			},
+			createIssueNoteFunc: func(string, int, string) (*gitlab.Note, error) {
+				fail("CreateIssueNote")
+				return nil, nil
+			},
+		}
+	}
+
+	t.Run("mr-label computes the final label set", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRLabelHandler(context.Background(), nil, MRLabelInput{
+			ProjectID: 1, MRIID: 10, Add: []string{"urgent"}, Remove: []string{"bug"}, DryRun: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.DryRun == nil || len(out.DryRun.Requests) != 1 {
+			t.Fatalf("expected one dry-run request, got %+v", out.DryRun)
+		}
+		if got := out.DryRun.Requests[0].Body["labels"]; got != "feature,urgent" {
+			t.Errorf("labels body = %v, want feature,urgent", got)
+		}
+		want := []gitlab.FieldChange{{Field: "labels", Before: "bug, feature", After: "feature, urgent"}}
+		if fmt.Sprint(out.DryRun.Changes) != fmt.Sprint(want) {
+			t.Errorf("changes = %+v, want %+v", out.DryRun.Changes, want)
+		}
+		if fmt.Sprint(out.Labels) != "[bug feature]" {
+			t.Errorf("labels = %v, want the current labels", out.Labels)
+		}
+	})
+
+	t.Run("mr-reviewer", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRReviewerHandler(context.Background(), nil, MRReviewerInput{
+			ProjectID: 1, MRIID: 10, Add: []int{7}, DryRun: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.DryRun.Changes) != 1 || out.DryRun.Changes[0].After != "bob, #7" {
+			t.Errorf("changes = %+v", out.DryRun.Changes)
+		}
+	})
+
+	t.Run("mr-update only reports changed fields", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRUpdateHandler(context.Background(), nil, MRUpdateInput{
+			ProjectID: 1, MRIID: 10, Title: strPtr("New title"), Draft: boolPtr(false), DryRun: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.DryRun.Changes) != 1 || out.DryRun.Changes[0].Field != "title" {
+			t.Errorf("changes = %+v, want only title", out.DryRun.Changes)
+		}
+		if out.DryRun.Requests[0].Body["draft"] != false {
+			t.Errorf("request body = %v, want draft=false", out.DryRun.Requests[0].Body)
+		}
+	})
+
+	t.Run("mr-create", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRCreateHandler(context.Background(), nil, MRCreateInput{
+			Project: "g/p", SourceBranch: "feat", TargetBranch: "main", Title: "Feat",
+			Labels: []string{"a"}, ReviewerIDs: []int{3}, DryRun: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.DryRun.Requests) != 3 {
+			t.Errorf("got %d requests, want create + labels + reviewers", len(out.DryRun.Requests))
+		}
+	})
+
+	t.Run("mr-merge with auto rebase", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRMergeHandler(context.Background(), nil, MRMergeInput{
+			ProjectID: 1, MRIID: 10, AutoRebase: true, DryRun: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Merged || len(out.DryRun.Requests) != 2 {
+			t.Errorf("output = %+v, want rebase + merge requests and no merge", out)
+		}
+	})
+
+	t.Run("mr-rebase", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRRebaseHandler(context.Background(), nil, MRRebaseInput{ProjectID: 1, MRIID: 10, DryRun: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Rebased || out.DryRun == nil {
+			t.Errorf("output = %+v", out)
+		}
+	})
+
+	t.Run("mr-approve", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		_, out, err := s.MRApproveHandler(context.Background(), nil, MRApproveInput{ProjectID: 1, MRIID: 10, SHA: "abc", DryRun: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.DryRun.Requests[0].Body["sha"] != "abc" {
+			t.Errorf("request = %+v", out.DryRun.Requests[0])
+		}
+	})
+
+	t.Run("issue tools", func(t *testing.T) {
+		s := testServer(noWrites(t))
+		ctx := context.Background()
+		if _, out, err := s.IssueCreateHandler(ctx, nil, IssueCreateInput{Project: "g/p", Title: "T", DryRun: true}); err != nil || out.DryRun == nil {
+			t.Errorf("issue-create: out=%+v err=%v", out, err)
+		}
+		if _, out, err := s.IssueUpdateHandler(ctx, nil, IssueUpdateInput{Project: "g/p", IssueIID: 3, StateEvent: strPtr("close"), DryRun: true}); err != nil || out.DryRun == nil || out.Issue.IID != 3 {
+			t.Errorf("issue-update: out=%+v err=%v", out, err)
+		}
+		if _, out, err := s.IssueCommentHandler(ctx, nil, IssueCommentInput{Project: "g/p", IssueIID: 3, Body: "hi", DryRun: true}); err != nil || out.DryRun == nil {
+			t.Errorf("issue-comment: out=%+v err=%v", out, err)
+		}
+	})
+}
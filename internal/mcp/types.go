@@ -1,5 +1,7 @@
 package mcp
 
+import "github.com/user/gitlab-cli/internal/gitlab"
+
 // --- Shared output types ---
 
 type MRSummary struct {
@@ -22,6 +24,7 @@ type MRDetail struct {
 	Assignees    []UserSummary      `json:"assignees"`
 	Discussions  []DiscussionOutput `json:"discussions,omitempty"`
 	Approvals    *ApprovalOutput    `json:"approvals,omitempty"`
+	ClosesIssues []IssueSummary     `json:"closes_issues,omitempty"`
 	Warnings     []string           `json:"warnings,omitempty"`
 }
 
@@ -31,6 +34,17 @@ type UserSummary struct {
 	Name     string `json:"name"`
 }
 
+// ReviewerSuggestion is a ranked code owner of an MR's changes.
+type ReviewerSuggestion struct {
+	UserSummary
+	Files     int     `json:"files"`
+	Load      int     `json:"load"`
+	Score     float64 `json:"score"`
+	Busy      bool    `json:"busy,omitempty"`
+	Status    string  `json:"status,omitempty"`
+	Reviewing bool    `json:"reviewing,omitempty"`
+}
+
 type DiscussionOutput struct {
 	ID    string       `json:"id"`
 	Notes []NoteOutput `json:"notes"`
@@ -47,8 +61,53 @@ type NoteOutput struct {
 }
 
 type ApprovalOutput struct {
-	Approved  bool     `json:"approved"`
-	Approvers []string `json:"approvers"`
+	Approved          bool     `json:"approved"`
+	ApprovalsRequired int      `json:"approvals_required"`
+	ApprovalsLeft     int      `json:"approvals_left"`
+	Approvers         []string `json:"approvers"`
+}
+
+type DiffChunkOutput struct {
+	Path        string `json:"path"`
+	OldPath     string `json:"old_path,omitempty"`
+	NewFile     bool   `json:"new_file,omitempty"`
+	DeletedFile bool   `json:"deleted_file,omitempty"`
+	RenamedFile bool   `json:"renamed_file,omitempty"`
+	Additions   int    `json:"additions"`
+	Deletions   int    `json:"deletions"`
+	Chunk       int    `json:"chunk"`
+	Chunks      int    `json:"chunks"`
+	Diff        string `json:"diff,omitempty"`
+	Omitted     string `json:"omitted,omitempty"`
+}
+
+type IssueSummary struct {
+	ID        int      `json:"id"`
+	IID       int      `json:"iid"`
+	ProjectID int      `json:"project_id"`
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	Author    string   `json:"author"`
+	Assignees []string `json:"assignees"`
+	Labels    []string `json:"labels"`
+	DueDate   string   `json:"due_date,omitempty"`
+	WebURL    string   `json:"web_url"`
+}
+
+type RelatedMROutput struct {
+	MRSummary
+	ClosesIssue bool `json:"closes_issue"`
+}
+
+type MilestoneOutput struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	StartDate string `json:"start_date,omitempty"`
+	DueDate   string `json:"due_date,omitempty"`
+	Expired   bool   `json:"expired"`
+	GroupID   int    `json:"group_id,omitempty"`
+	WebURL    string `json:"web_url"`
 }
 
 type EventOutput struct {
@@ -60,6 +119,7 @@ type EventOutput struct {
 	TargetID    int    `json:"target_id,omitempty"`
 	TargetIID   int    `json:"target_iid,omitempty"`
 	TargetTitle string `json:"target_title,omitempty"`
+	Author      string `json:"author,omitempty"`
 }
 
 type ProjectOutput struct {
@@ -84,3 +144,16 @@ type MRAlternative struct {
 	Title     string `json:"title"`
 	WebURL    string `json:"web_url"`
 }
+
+// DryRunOutput describes what a write tool would have done when called with
+// dry_run: the requests it would send and, for merge request updates, the
+// fields that would change.
+type DryRunOutput struct {
+	Requests []DryRunRequest      `json:"requests"`
+	Changes  []gitlab.FieldChange `json:"changes,omitempty"`
+}
+
+type DryRunRequest struct {
+	Action string                 `json:"action"`
+	Body   map[string]interface{} `json:"body,omitempty"`
+}
@@ -34,6 +34,10 @@ type MergeOptions struct {
 	MaxRetries   int
 	Timeout      time.Duration
 	PollInterval time.Duration
+
+	// Wake, when set, ends a poll wait early. Webhook receivers send on it
+	// when GitLab reports a change of the MR.
+	Wake <-chan struct{}
 }
 
 // MergeResult holds the outcome of a merge operation.
@@ -148,7 +152,7 @@ func MergeWithRebase(ctx context.Context, client MergeClient, opts MergeOptions,
 				}
 			}
 			notify("waiting", "Waiting for CI")
-			sleep(ctx, opts.PollInterval)
+			sleep(ctx, opts.PollInterval, opts.Wake)
 
 		default:
 			return nil, fmt.Errorf("%w: unexpected merge status: %s", ErrGitLabAPI, mr.DetailedMergeStatus)
@@ -165,7 +169,7 @@ func waitForRebase(ctx context.Context, client MergeClient, opts MergeOptions, n
 		default:
 		}
 
-		sleep(ctx, opts.PollInterval)
+		sleep(ctx, opts.PollInterval, opts.Wake)
 
 		mr, err := client.GetMR(opts.ProjectID, opts.MRIID)
 		if err != nil {
@@ -182,11 +186,12 @@ func waitForRebase(ctx context.Context, client MergeClient, opts MergeOptions, n
 	}
 }
 
-func sleep(ctx context.Context, d time.Duration) {
+func sleep(ctx context.Context, d time.Duration, wake <-chan struct{}) {
 	timer := time.NewTimer(d)
 	defer timer.Stop()
 	select {
 	case <-ctx.Done():
 	case <-timer.C:
+	case <-wake:
 	}
 }
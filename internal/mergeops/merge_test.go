@@ -369,3 +369,32 @@ func TestStatusCallback(t *testing.T) {
 		t.Error("callback was never called")
 	}
 }
+
+func TestWakeEndsPollWait(t *testing.T) {
+	calls := 0
+	client := &mockMergeClient{
+		getMRFunc: func(_, _ int) (*gitlab.MergeRequest, error) {
+			calls++
+			if calls == 1 {
+				return &gitlab.MergeRequest{DetailedMergeStatus: "ci_still_running"}, nil
+			}
+			return &gitlab.MergeRequest{DetailedMergeStatus: "mergeable"}, nil
+		},
+	}
+
+	wake := make(chan struct{}, 1)
+	wake <- struct{}{}
+
+	opts := defaultOpts()
+	opts.PollInterval = time.Hour
+	opts.Timeout = 5 * time.Second
+	opts.Wake = wake
+
+	result, err := MergeWithRebase(context.Background(), client, opts, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Merged {
+		t.Error("expected merge after wake")
+	}
+}
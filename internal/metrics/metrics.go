@@ -0,0 +1,340 @@
+// Package metrics measures the review cycle of merged merge requests and
+// summarizes it as percentiles per author or team.
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+// Client is the subset of gitlab.Client methods needed to measure an MR.
+type Client interface {
+	GetMRDiscussions(projectID, iid int) ([]gitlab.Discussion, error)
+	GetMRApprovals(projectID, iid int) (*gitlab.ApprovalState, error)
+	GetMRPipelines(projectID, mrIID int) ([]gitlab.PipelineInfo, error)
+	GetMRVersions(projectID, iid int) ([]gitlab.MRVersion, error)
+	GetMRDiffs(projectID, iid int) ([]gitlab.MRDiff, error)
+}
+
+// MR is the review cycle of one merged MR. Durations are negative when the
+// event never happened.
+type MR struct {
+	ProjectID int
+	IID       int
+	Author    string
+	CreatedAt time.Time
+	MergedAt  time.Time
+
+	// FirstReview and Approval are measured from when the MR was ready for
+	// review: its creation, or for MRs opened as drafts when they were
+	// marked ready. Approval is the last approval before the merge.
+	FirstReview time.Duration
+	Approval    time.Duration
+	Merge       time.Duration // from creation
+
+	Rebases int // pushes onto a new target branch base
+	Reruns  int // pipelines run again for a commit that already had one
+	Size    int // lines added and removed
+}
+
+var (
+	readyRe = regexp.MustCompile(`(?i)marked (this merge request )?as \*\*ready\*\*|unmarked as a \*\*work in progress\*\*`)
+	draftRe = regexp.MustCompile(`(?i)marked (this merge request )?as (\*\*draft\*\*|a \*\*work in progress\*\*)`)
+
+	// tokenBotRe matches the users GitLab creates for project and group
+	// access tokens, whose notes may lack the bot flag
+	tokenBotRe = regexp.MustCompile(`^(project|group)_\d+_bot(_[0-9a-f]+)?$`)
+)
+
+// toolMarker starts the hidden marker of notes posted by gitlab-cli itself,
+// such as stale reminders, which are no review.
+const toolMarker = "<!-- gitlab-cli "
+
+// isBot reports whether u is a bot, whose notes and approvals are no review.
+func isBot(u gitlab.User) bool {
+	return u.Bot || tokenBotRe.MatchString(u.Username)
+}
+
+// Measure fetches the notes, approvals, pipelines, versions and diffs of a
+// merged MR and measures its review cycle.
+func Measure(client Client, mr gitlab.MergeRequest) (*MR, error) {
+	discussions, err := client.GetMRDiscussions(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := client.GetMRApprovals(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	pipelines, err := client.GetMRPipelines(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := client.GetMRVersions(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	diffs, err := client.GetMRDiffs(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	return Compute(mr, discussions, approvals, pipelines, versions, diffs), nil
+}
+
+// Compute measures the review cycle of mr from its API data.
+func Compute(mr gitlab.MergeRequest, discussions []gitlab.Discussion, approvals *gitlab.ApprovalState, pipelines []gitlab.PipelineInfo, versions []gitlab.MRVersion, diffs []gitlab.MRDiff) *MR {
+	m := &MR{
+		ProjectID:   mr.ProjectID,
+		IID:         mr.IID,
+		Author:      mr.Author.Username,
+		CreatedAt:   parseTime(mr.CreatedAt),
+		MergedAt:    parseTime(mr.MergedAt),
+		FirstReview: -1,
+		Approval:    -1,
+		Merge:       -1,
+	}
+	if !m.MergedAt.IsZero() {
+		m.Merge = m.MergedAt.Sub(m.CreatedAt)
+	}
+
+	var notes []gitlab.Note
+	for _, d := range discussions {
+		notes = append(notes, d.Notes...)
+	}
+	sort.SliceStable(notes, func(i, j int) bool { return parseTime(notes[i].CreatedAt).Before(parseTime(notes[j].CreatedAt)) })
+
+	// An MR opened as draft is first marked ready, not draft
+	ready := m.CreatedAt
+	for _, n := range notes {
+		if !n.System {
+			continue
+		}
+		if draftRe.MatchString(n.Body) && !readyRe.MatchString(n.Body) {
+			break
+		}
+		if readyRe.MatchString(n.Body) {
+			ready = parseTime(n.CreatedAt)
+			break
+		}
+	}
+
+	var firstReview, approved time.Time
+	review := func(t time.Time) {
+		if !t.IsZero() && (firstReview.IsZero() || t.Before(firstReview)) {
+			firstReview = t
+		}
+	}
+	approve := func(t time.Time) {
+		if t.IsZero() || (!m.MergedAt.IsZero() && t.After(m.MergedAt)) {
+			return
+		}
+		review(t)
+		if t.After(approved) {
+			approved = t
+		}
+	}
+
+	for _, n := range notes {
+		if strings.EqualFold(n.Author.Username, m.Author) || isBot(n.Author) {
+			continue
+		}
+		at := parseTime(n.CreatedAt)
+		switch {
+		case n.System && n.Body == "approved this merge request":
+			approve(at)
+		case n.System && n.Body == "requested changes":
+			review(at)
+		case !n.System && !strings.Contains(n.Body, toolMarker):
+			review(at)
+		}
+	}
+	if approvals != nil {
+		for _, a := range approvals.Approvers {
+			if !isBot(a.User) {
+				approve(parseTime(a.ApprovedAt))
+			}
+		}
+	}
+
+	if !firstReview.IsZero() {
+		m.FirstReview = max(firstReview.Sub(ready), 0)
+	}
+	if !approved.IsZero() {
+		m.Approval = max(approved.Sub(ready), 0)
+	}
+
+	// Versions come newest first; a new base commit means a rebase
+	for i := len(versions) - 2; i >= 0; i-- {
+		if versions[i].BaseCommitSHA != versions[i+1].BaseCommitSHA {
+			m.Rebases++
+		}
+	}
+
+	shas := make(map[string]bool)
+	for _, p := range pipelines {
+		if shas[p.SHA] {
+			m.Reruns++
+		}
+		shas[p.SHA] = true
+	}
+
+	for _, d := range diffs {
+		added, removed := gitlab.DiffStats(d.Diff)
+		m.Size += added + removed
+	}
+
+	return m
+}
+
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+// MeasureAll measures mrs with up to workers concurrent requests and
+// returns the results in the order of mrs, or the error of the first MR
+// that could not be measured.
+func MeasureAll(client Client, mrs []gitlab.MergeRequest, workers int) ([]MR, error) {
+	results := make([]MR, len(mrs))
+	errs := make([]error, len(mrs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(workers, 1))
+	for i, mr := range mrs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			m, err := Measure(client, mr)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = *m
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("!%d (project %d): %w", mrs[i].IID, mrs[i].ProjectID, err)
+		}
+	}
+	return results, nil
+}
+
+// Size classes by lines changed.
+var SizeClasses = []struct {
+	Name     string
+	MaxLines int // inclusive; the last class is unbounded
+}{
+	{"XS", 10},
+	{"S", 50},
+	{"M", 250},
+	{"L", 1000},
+	{"XL", math.MaxInt},
+}
+
+// SizeClass returns the name of the size class of an MR changing lines lines.
+func SizeClass(lines int) string {
+	for _, c := range SizeClasses {
+		if lines <= c.MaxLines {
+			return c.Name
+		}
+	}
+	return SizeClasses[len(SizeClasses)-1].Name
+}
+
+// Percentiles summarizes a distribution by nearest rank. N is the number
+// of values; the percentiles are zero when there are none.
+type Percentiles struct {
+	N   int     `json:"n"`
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P90 float64 `json:"p90"`
+}
+
+// NewPercentiles returns the percentiles of values.
+func NewPercentiles(values []float64) Percentiles {
+	p := Percentiles{N: len(values)}
+	if p.N == 0 {
+		return p
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	rank := func(q float64) float64 {
+		return sorted[max(int(math.Ceil(q*float64(p.N)))-1, 0)]
+	}
+	p.P50, p.P75, p.P90 = rank(0.50), rank(0.75), rank(0.90)
+	return p
+}
+
+// Stats summarizes the MRs of an author or team. Times are in hours.
+type Stats struct {
+	Group       string         `json:"group"`
+	MRs         int            `json:"mrs"`
+	FirstReview Percentiles    `json:"first_review_hours"`
+	Approval    Percentiles    `json:"approval_hours"`
+	Merge       Percentiles    `json:"merge_hours"`
+	Rebases     int            `json:"rebases"`
+	Reruns      int            `json:"pipeline_reruns"`
+	Size        Percentiles    `json:"size_lines"`
+	Sizes       map[string]int `json:"sizes"`
+}
+
+// Summarize computes the stats of mrs.
+func Summarize(group string, mrs []MR) Stats {
+	s := Stats{Group: group, MRs: len(mrs), Sizes: make(map[string]int)}
+	var review, approval, merge, size []float64
+	hours := func(values *[]float64, d time.Duration) {
+		if d >= 0 {
+			*values = append(*values, d.Hours())
+		}
+	}
+	for _, m := range mrs {
+		hours(&review, m.FirstReview)
+		hours(&approval, m.Approval)
+		hours(&merge, m.Merge)
+		size = append(size, float64(m.Size))
+		s.Rebases += m.Rebases
+		s.Reruns += m.Reruns
+		s.Sizes[SizeClass(m.Size)]++
+	}
+	s.FirstReview = NewPercentiles(review)
+	s.Approval = NewPercentiles(approval)
+	s.Merge = NewPercentiles(merge)
+	s.Size = NewPercentiles(size)
+	return s
+}
+
+// GroupBy summarizes mrs per group, sorted by group name. groups returns
+// the groups of an MR; an MR may count in several.
+func GroupBy(mrs []MR, groups func(MR) []string) []Stats {
+	byGroup := make(map[string][]MR)
+	for _, m := range mrs {
+		for _, g := range groups(m) {
+			byGroup[g] = append(byGroup[g], m)
+		}
+	}
+
+	names := make([]string, 0, len(byGroup))
+	for g := range byGroup {
+		names = append(names, g)
+	}
+	sort.Strings(names)
+
+	stats := make([]Stats, len(names))
+	for i, g := range names {
+		stats[i] = Summarize(g, byGroup[g])
+	}
+	return stats
+}
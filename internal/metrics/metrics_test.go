@@ -0,0 +1,135 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func TestCompute(t *testing.T) {
+	mr := gitlab.MergeRequest{
+		ProjectID: 10,
+		IID:       1,
+		Author:    gitlab.User{Username: "alice"},
+		CreatedAt: "2024-05-01T08:00:00Z",
+		MergedAt:  "2024-05-03T08:00:00Z",
+	}
+	discussions := []gitlab.Discussion{
+		{Notes: []gitlab.Note{
+			{Author: gitlab.User{Username: "alice"}, Body: "marked this merge request as **ready**", System: true, CreatedAt: "2024-05-01T10:00:00Z"},
+			{Author: gitlab.User{Username: "alice"}, Body: "Please have a look", CreatedAt: "2024-05-01T10:05:00Z"},
+		}},
+		{Notes: []gitlab.Note{
+			{Author: gitlab.User{Username: "bot"}, Body: "stale\n\n<!-- gitlab-cli stale -->", CreatedAt: "2024-05-01T10:30:00Z"},
+			{Author: gitlab.User{Username: "bob"}, Body: "Nit: rename this", CreatedAt: "2024-05-01T14:00:00Z"},
+		}},
+		{Notes: []gitlab.Note{
+			{Author: gitlab.User{Username: "bob"}, Body: "approved this merge request", System: true, CreatedAt: "2024-05-02T10:00:00Z"},
+		}},
+	}
+	approvals := &gitlab.ApprovalState{Approvers: []gitlab.ApprovalUser{
+		{User: gitlab.User{Username: "bob"}, ApprovedAt: "2024-05-02T10:00:00Z"},
+		{User: gitlab.User{Username: "carol"}, ApprovedAt: "2024-05-02T16:00:00Z"},
+	}}
+	pipelines := []gitlab.PipelineInfo{{SHA: "c3"}, {SHA: "c2"}, {SHA: "c2"}, {SHA: "c1"}, {SHA: "c2"}}
+	versions := []gitlab.MRVersion{
+		{ID: 4, BaseCommitSHA: "b2"},
+		{ID: 3, BaseCommitSHA: "b2"},
+		{ID: 2, BaseCommitSHA: "b1"},
+		{ID: 1, BaseCommitSHA: "b1"},
+	}
+	diffs := []gitlab.MRDiff{{Diff: "@@ -1,2 +1,3 @@\n-old\n+new\n+more\n ctx\n"}}
+
+	m := Compute(mr, discussions, approvals, pipelines, versions, diffs)
+
+	// Review times count from when the draft was marked ready at 10:00
+	if m.FirstReview != 4*time.Hour {
+		t.Errorf("first review = %v, want 4h", m.FirstReview)
+	}
+	if m.Approval != 30*time.Hour {
+		t.Errorf("approval = %v, want 30h", m.Approval)
+	}
+	if m.Merge != 48*time.Hour {
+		t.Errorf("merge = %v, want 48h", m.Merge)
+	}
+	if m.Rebases != 1 || m.Reruns != 2 || m.Size != 3 {
+		t.Errorf("rebases %d, reruns %d, size %d; want 1, 2, 3", m.Rebases, m.Reruns, m.Size)
+	}
+
+	// Without reviews or approvals those durations are unknown
+	m = Compute(mr, nil, nil, nil, nil, nil)
+	if m.FirstReview >= 0 || m.Approval >= 0 || m.Merge != 48*time.Hour {
+		t.Errorf("unexpected metrics %+v", m)
+	}
+}
+
+func TestComputeDraftAfterCreation(t *testing.T) {
+	mr := gitlab.MergeRequest{Author: gitlab.User{Username: "alice"}, CreatedAt: "2024-05-01T08:00:00Z"}
+	discussions := []gitlab.Discussion{{Notes: []gitlab.Note{
+		{Body: "marked this merge request as **draft**", System: true, CreatedAt: "2024-05-01T09:00:00Z"},
+		{Body: "marked this merge request as **ready**", System: true, CreatedAt: "2024-05-01T12:00:00Z"},
+		{Author: gitlab.User{Username: "bob"}, Body: "LGTM", CreatedAt: "2024-05-01T10:00:00Z"},
+	}}}
+
+	// Opened ready, so the review clock starts at creation
+	if m := Compute(mr, discussions, nil, nil, nil, nil); m.FirstReview != 2*time.Hour {
+		t.Errorf("first review = %v, want 2h", m.FirstReview)
+	}
+}
+
+func TestComputeIgnoresBots(t *testing.T) {
+	mr := gitlab.MergeRequest{Author: gitlab.User{Username: "alice"}, CreatedAt: "2024-05-01T08:00:00Z", MergedAt: "2024-05-02T08:00:00Z"}
+	discussions := []gitlab.Discussion{{Notes: []gitlab.Note{
+		{Author: gitlab.User{Username: "renovate", Bot: true}, Body: "Coverage report: 84%", CreatedAt: "2024-05-01T08:05:00Z"},
+		{Author: gitlab.User{Username: "project_42_bot_3f2a9c"}, Body: "Deployed to review app", CreatedAt: "2024-05-01T08:10:00Z"},
+		{Author: gitlab.User{Username: "bob"}, Body: "Looks good", CreatedAt: "2024-05-01T11:00:00Z"},
+	}}}
+	approvals := &gitlab.ApprovalState{Approvers: []gitlab.ApprovalUser{
+		{User: gitlab.User{Username: "approval-bot", Bot: true}, ApprovedAt: "2024-05-01T08:15:00Z"},
+	}}
+
+	m := Compute(mr, discussions, approvals, nil, nil, nil)
+	if m.FirstReview != 3*time.Hour {
+		t.Errorf("first review = %v, want 3h", m.FirstReview)
+	}
+	if m.Approval >= 0 {
+		t.Errorf("approval = %v, want none", m.Approval)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	p := NewPercentiles([]float64{9, 1, 8, 2, 7, 3, 6, 4, 5, 10})
+	if p.N != 10 || p.P50 != 5 || p.P75 != 8 || p.P90 != 9 {
+		t.Errorf("percentiles = %+v", p)
+	}
+	if p := NewPercentiles([]float64{3}); p.P50 != 3 || p.P90 != 3 {
+		t.Errorf("single value: %+v", p)
+	}
+	if p := NewPercentiles(nil); p != (Percentiles{}) {
+		t.Errorf("no values: %+v", p)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	mrs := []MR{
+		{Author: "alice", FirstReview: time.Hour, Approval: 2 * time.Hour, Merge: 3 * time.Hour, Size: 5, Rebases: 1},
+		{Author: "alice", FirstReview: -1, Approval: -1, Merge: 5 * time.Hour, Size: 300, Reruns: 2},
+		{Author: "bob", FirstReview: 4 * time.Hour, Approval: 4 * time.Hour, Merge: 8 * time.Hour, Size: 2000},
+	}
+	stats := GroupBy(mrs, func(m MR) []string { return []string{m.Author} })
+	if len(stats) != 2 || stats[0].Group != "alice" || stats[1].Group != "bob" {
+		t.Fatalf("unexpected groups %+v", stats)
+	}
+
+	a := stats[0]
+	if a.MRs != 2 || a.FirstReview.N != 1 || a.Merge.N != 2 || a.Merge.P90 != 5 {
+		t.Errorf("unexpected stats %+v", a)
+	}
+	if a.Rebases != 1 || a.Reruns != 2 || a.Sizes["XS"] != 1 || a.Sizes["L"] != 1 {
+		t.Errorf("unexpected counts %+v", a)
+	}
+	if stats[1].Sizes["XL"] != 1 {
+		t.Errorf("unexpected sizes %+v", stats[1].Sizes)
+	}
+}
@@ -0,0 +1,174 @@
+// Package reviewers picks merge request reviewers: code owners from the
+// project's CODEOWNERS file weighted by their review load and availability,
+// or the next members of a configured team roster.
+package reviewers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CodeOwnersPaths are the locations GitLab reads CODEOWNERS from, in order.
+var CodeOwnersPaths = []string{"CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+
+// Rule is one pattern line of a CODEOWNERS file.
+type Rule struct {
+	Section string
+	Pattern string
+	Owners  []string // @user, @group/subgroup or email, as written
+
+	re *regexp.Regexp
+}
+
+// CodeOwners is a parsed CODEOWNERS file.
+type CodeOwners struct {
+	Rules []Rule
+}
+
+// ParseCodeOwners parses a CODEOWNERS file in GitLab's syntax: one pattern
+// per line followed by its owners, optionally grouped in [Section] headers
+// whose default owners apply to entries without owners of their own.
+func ParseCodeOwners(content string) *CodeOwners {
+	co := &CodeOwners{}
+	section := ""
+	var defaults []string
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if name, owners, ok := parseSection(line); ok {
+			section, defaults = name, owners
+			continue
+		}
+
+		fields := splitFields(line)
+		owners := fields[1:]
+		if len(owners) == 0 {
+			owners = defaults
+		}
+		co.Rules = append(co.Rules, Rule{
+			Section: section,
+			Pattern: fields[0],
+			Owners:  owners,
+			re:      compilePattern(fields[0]),
+		})
+	}
+
+	return co
+}
+
+// Owners returns the owners of path: in each section the last matching rule
+// wins, and the owners of all sections are combined.
+func (co *CodeOwners) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+
+	var sections []string
+	last := make(map[string]*Rule)
+	for i := range co.Rules {
+		r := &co.Rules[i]
+		if !r.re.MatchString(path) {
+			continue
+		}
+		key := strings.ToLower(r.Section)
+		if _, ok := last[key]; !ok {
+			sections = append(sections, key)
+		}
+		last[key] = r
+	}
+
+	var owners []string
+	seen := make(map[string]bool)
+	for _, key := range sections {
+		for _, o := range last[key].Owners {
+			if !seen[strings.ToLower(o)] {
+				seen[strings.ToLower(o)] = true
+				owners = append(owners, o)
+			}
+		}
+	}
+	return owners
+}
+
+var sectionRe = regexp.MustCompile(`^\^?\[([^\]]+)\](?:\[\d+\])?\s*(.*)$`)
+
+// parseSection parses a "[Name]", "^[Optional]" or "[Name][2]" header with
+// optional default owners.
+func parseSection(line string) (string, []string, bool) {
+	match := sectionRe.FindStringSubmatch(line)
+	if match == nil {
+		return "", nil, false
+	}
+	return strings.TrimSpace(match[1]), strings.Fields(match[2]), true
+}
+
+// splitFields splits a rule line on whitespace, keeping escaped spaces in
+// the pattern and dropping a trailing comment.
+func splitFields(line string) []string {
+	var fields []string
+	var cur strings.Builder
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '#' && cur.Len() == 0 && len(fields) > 0:
+			return fields
+		case r == ' ' || r == '\t':
+			if cur.Len() > 0 {
+				fields = append(fields, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		fields = append(fields, cur.String())
+	}
+	return fields
+}
+
+// compilePattern turns a CODEOWNERS pattern into a regular expression. A
+// pattern without a leading slash matches at any depth, a trailing slash
+// matches everything below the directory, and a pattern naming a directory
+// also matches the files in it.
+func compilePattern(pattern string) *regexp.Regexp {
+	anchored := strings.HasPrefix(pattern, "/")
+	dir := strings.HasSuffix(pattern, "/")
+	body := strings.Trim(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(body); i++ {
+		switch c := body[i]; {
+		case c == '*' && strings.HasPrefix(body[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case c == '*' && strings.HasPrefix(body[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(body[i : i+1]))
+		}
+	}
+	if dir {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,66 @@
+package reviewers
+
+import (
+	"slices"
+	"testing"
+)
+
+const sampleCodeOwners = `# Default owners
+* @lead
+
+*.md @docs-team
+/internal/gitlab/ @alice @bob
+internal/cli/*.go @carol
+/build/**/Dockerfile @ops
+my\ file.txt @dave
+
+[Frontend][2] @frontend/reviewers
+web/
+web/legacy/ @erin
+
+^[Security]
+go.sum @sec
+`
+
+func TestCodeOwners(t *testing.T) {
+	co := ParseCodeOwners(sampleCodeOwners)
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"main.go", []string{"@lead"}},
+		{"README.md", []string{"@docs-team"}},
+		{"docs/guide.md", []string{"@docs-team"}},
+		{"internal/gitlab/mr.go", []string{"@alice", "@bob"}},
+		{"vendor/internal/gitlab/mr.go", []string{"@lead"}},
+		{"internal/cli/mr.go", []string{"@carol"}},
+		{"internal/cli/sub/mr.go", []string{"@lead"}},
+		{"build/Dockerfile", []string{"@ops"}},
+		{"build/images/api/Dockerfile", []string{"@ops"}},
+		{"my file.txt", []string{"@dave"}},
+		{"web/app.js", []string{"@lead", "@frontend/reviewers"}},
+		{"web/legacy/old.js", []string{"@lead", "@erin"}},
+		{"go.sum", []string{"@lead", "@sec"}},
+	}
+
+	for _, tt := range tests {
+		if got := co.Owners(tt.path); !slices.Equal(got, tt.want) {
+			t.Errorf("Owners(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCodeOwnersSections(t *testing.T) {
+	co := ParseCodeOwners(sampleCodeOwners)
+
+	var sections []string
+	for _, r := range co.Rules {
+		if !slices.Contains(sections, r.Section) {
+			sections = append(sections, r.Section)
+		}
+	}
+	if !slices.Equal(sections, []string{"", "Frontend", "Security"}) {
+		t.Errorf("sections = %v", sections)
+	}
+}
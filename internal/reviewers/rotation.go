@@ -0,0 +1,260 @@
+package reviewers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/config"
+	"github.com/user/gitlab-cli/internal/timerange"
+)
+
+// Roster strategies.
+const (
+	RoundRobin  = "round-robin"
+	LeastLoaded = "least-loaded"
+)
+
+// Roster is a validated team from the configuration.
+type Roster struct {
+	Name      string
+	Strategy  string
+	Reviewers int
+	Members   []Member
+}
+
+// Member is a roster member with their out-of-office periods.
+type Member struct {
+	Username string
+	Weight   int
+	Away     []timerange.Range
+}
+
+// NewRoster validates team and parses its away periods in loc.
+func NewRoster(name string, team config.Team, loc *time.Location) (*Roster, error) {
+	r := &Roster{Name: name, Strategy: team.Strategy, Reviewers: team.Reviewers}
+	switch r.Strategy {
+	case "":
+		r.Strategy = RoundRobin
+	case RoundRobin, LeastLoaded:
+	default:
+		return nil, fmt.Errorf("team %s: invalid strategy '%s' (use %s or %s)", name, team.Strategy, RoundRobin, LeastLoaded)
+	}
+	if r.Reviewers <= 0 {
+		r.Reviewers = 1
+	}
+	if len(team.Members) == 0 {
+		return nil, fmt.Errorf("team %s has no members", name)
+	}
+
+	for _, tm := range team.Members {
+		m := Member{Username: strings.TrimPrefix(tm.Username, "@"), Weight: tm.Weight}
+		if m.Username == "" {
+			return nil, fmt.Errorf("team %s: member without username", name)
+		}
+		if m.Weight < 0 {
+			return nil, fmt.Errorf("team %s: negative weight for %s", name, m.Username)
+		}
+		if m.Weight == 0 {
+			m.Weight = 1
+		}
+		for _, spec := range tm.Away {
+			period, err := parsePeriod(spec, loc)
+			if err != nil {
+				return nil, fmt.Errorf("team %s: away of %s: %w", name, m.Username, err)
+			}
+			m.Away = append(m.Away, period)
+		}
+		r.Members = append(r.Members, m)
+	}
+
+	return r, nil
+}
+
+// parsePeriod parses "YYYY-MM-DD" or an inclusive "YYYY-MM-DD..YYYY-MM-DD".
+func parsePeriod(spec string, loc *time.Location) (timerange.Range, error) {
+	from, to, ok := strings.Cut(spec, "..")
+	if !ok {
+		to = from
+	}
+	start, err := timerange.Parse(strings.TrimSpace(from), loc)
+	if err != nil {
+		return timerange.Range{}, err
+	}
+	end, err := timerange.Parse(strings.TrimSpace(to), loc)
+	if err != nil {
+		return timerange.Range{}, err
+	}
+	if end.To.Before(start.To) {
+		return timerange.Range{}, fmt.Errorf("period '%s' ends before it starts", spec)
+	}
+	return timerange.Range{From: start.From, To: end.To}, nil
+}
+
+// awayUntil returns the end of the away period containing t, or zero when
+// the member is available.
+func (m Member) awayUntil(t time.Time) time.Time {
+	for _, p := range m.Away {
+		if p.Contains(t) {
+			return p.To
+		}
+	}
+	return time.Time{}
+}
+
+// QueueEntry is a member's place in the rotation.
+type QueueEntry struct {
+	Username  string    `json:"username"`
+	Weight    int       `json:"weight"`
+	Assigned  int       `json:"assigned"`
+	LastAt    time.Time `json:"last_at,omitzero"`
+	Load      int       `json:"load"` // -1 when not known
+	AwayUntil time.Time `json:"away_until,omitzero"`
+}
+
+// Away reports whether the member is out of office.
+func (e QueueEntry) Away() bool {
+	return !e.AwayUntil.IsZero()
+}
+
+// Queue orders the members by who is next. Available members come first.
+// Round-robin orders them by reviews assigned relative to their weight and
+// then by who waited longest; least-loaded orders them by open reviews
+// relative to their weight first. loads holds the review load by username;
+// missing users count as unknown.
+func (r *Roster) Queue(st *TeamRotation, loads map[string]int, now time.Time) []QueueEntry {
+	queue := make([]QueueEntry, len(r.Members))
+	for i, m := range r.Members {
+		load, ok := loads[m.Username]
+		if !ok {
+			load = -1
+		}
+		queue[i] = QueueEntry{
+			Username:  m.Username,
+			Weight:    m.Weight,
+			Assigned:  st.Assigned[m.Username],
+			LastAt:    st.LastAt[m.Username],
+			Load:      load,
+			AwayUntil: m.awayUntil(now),
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		a, b := queue[i], queue[j]
+		if a.Away() != b.Away() {
+			return b.Away()
+		}
+		if r.Strategy == LeastLoaded {
+			la, lb := max(a.Load, 0)*b.Weight, max(b.Load, 0)*a.Weight
+			if la != lb {
+				return la < lb
+			}
+		}
+		// Whoever would be least over their share after one more review
+		if sa, sb := (a.Assigned+1)*b.Weight, (b.Assigned+1)*a.Weight; sa != sb {
+			return sa < sb
+		}
+		return a.LastAt.Before(b.LastAt)
+	})
+
+	return queue
+}
+
+// Next returns the first n available members of queue, skipping the
+// excluded usernames (such as the MR author).
+func Next(queue []QueueEntry, n int, exclude ...string) []QueueEntry {
+	var next []QueueEntry
+	for _, e := range queue {
+		if len(next) == n {
+			break
+		}
+		excluded := slices.ContainsFunc(exclude, func(u string) bool { return strings.EqualFold(u, e.Username) })
+		if e.Away() || excluded {
+			continue
+		}
+		next = append(next, e)
+	}
+	return next
+}
+
+// RotationState is the persisted rotation of all teams.
+type RotationState struct {
+	Teams map[string]*TeamRotation `json:"teams"`
+}
+
+// TeamRotation counts the reviews assigned to each member of a team and
+// when they were last assigned one.
+type TeamRotation struct {
+	Assigned map[string]int       `json:"assigned"`
+	LastAt   map[string]time.Time `json:"last_at"`
+}
+
+// LoadRotation reads the rotation state from path; a missing file is an
+// empty state.
+func LoadRotation(path string) (*RotationState, error) {
+	st := &RotationState{Teams: make(map[string]*TeamRotation)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading rotation state: %w", err)
+	}
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("reading rotation state: %w", err)
+	}
+	if st.Teams == nil {
+		st.Teams = make(map[string]*TeamRotation)
+	}
+	return st, nil
+}
+
+// Save writes the rotation state to path, replacing it atomically.
+func (s *RotationState) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("writing rotation state: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing rotation state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("writing rotation state: %w", err)
+	}
+	return nil
+}
+
+// Team returns the rotation of the named team, creating it if needed.
+func (s *RotationState) Team(name string) *TeamRotation {
+	t := s.Teams[name]
+	if t == nil {
+		t = &TeamRotation{}
+		s.Teams[name] = t
+	}
+	if t.Assigned == nil {
+		t.Assigned = make(map[string]int)
+	}
+	if t.LastAt == nil {
+		t.LastAt = make(map[string]time.Time)
+	}
+	return t
+}
+
+// Record counts a review assigned to each of usernames at now.
+func (t *TeamRotation) Record(usernames []string, now time.Time) {
+	for _, u := range usernames {
+		t.Assigned[u]++
+		t.LastAt[u] = now.UTC()
+	}
+}
@@ -0,0 +1,111 @@
+package reviewers
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/config"
+)
+
+func usernames(queue []QueueEntry) []string {
+	var names []string
+	for _, e := range queue {
+		names = append(names, e.Username)
+	}
+	return names
+}
+
+func testTeam(strategy string) config.Team {
+	return config.Team{
+		Strategy: strategy,
+		Members: []config.TeamMember{
+			{Username: "alice", Weight: 2},
+			{Username: "@bob"},
+			{Username: "carol", Away: []string{"2024-07-01..2024-07-14"}},
+		},
+	}
+}
+
+func TestNewRoster(t *testing.T) {
+	r, err := NewRoster("backend", testTeam(""), time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Strategy != RoundRobin || r.Reviewers != 1 || r.Members[1].Username != "bob" || r.Members[1].Weight != 1 {
+		t.Errorf("unexpected roster %+v", r)
+	}
+
+	bad := []config.Team{
+		{Strategy: "random", Members: []config.TeamMember{{Username: "alice"}}},
+		{},
+		{Members: []config.TeamMember{{Username: "alice", Weight: -1}}},
+		{Members: []config.TeamMember{{Username: "alice", Away: []string{"2024-07-14..2024-07-01"}}}},
+		{Members: []config.TeamMember{{Username: "alice", Away: []string{"next week"}}}},
+	}
+	for _, team := range bad {
+		if _, err := NewRoster("bad", team, time.UTC); err == nil {
+			t.Errorf("NewRoster(%+v) should fail", team)
+		}
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	r, _ := NewRoster("backend", testTeam(RoundRobin), time.UTC)
+	st := (&RotationState{Teams: map[string]*TeamRotation{}}).Team("backend")
+	now := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+
+	// alice has twice bob's weight, so she takes two of every three reviews
+	var picked []string
+	for i := range 6 {
+		next := Next(r.Queue(st, nil, now), 1)
+		picked = append(picked, next[0].Username)
+		st.Record(usernames(next), now.Add(time.Duration(i)*time.Minute))
+	}
+	if got := []string{"alice", "bob", "carol", "alice", "alice", "bob"}; !slices.Equal(picked, got) {
+		t.Errorf("picked %v, want %v", picked, got)
+	}
+
+	// carol is away in July and goes to the back of the queue
+	queue := r.Queue(st, nil, time.Date(2024, 7, 5, 9, 0, 0, 0, time.UTC))
+	if names := usernames(queue); names[2] != "carol" || !queue[2].Away() || queue[2].AwayUntil.Format("2006-01-02") != "2024-07-15" {
+		t.Errorf("queue = %+v", queue)
+	}
+	if next := Next(queue, 3, "alice"); !slices.Equal(usernames(next), []string{"bob"}) {
+		t.Errorf("next = %v, want [bob]", usernames(next))
+	}
+}
+
+func TestLeastLoaded(t *testing.T) {
+	r, _ := NewRoster("backend", testTeam(LeastLoaded), time.UTC)
+	st := (&RotationState{Teams: map[string]*TeamRotation{}}).Team("backend")
+	now := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+
+	// alice's four reviews count as two with her weight of two
+	loads := map[string]int{"alice": 4, "bob": 3, "carol": 2}
+	if got := usernames(r.Queue(st, loads, now)); !slices.Equal(got, []string{"alice", "carol", "bob"}) {
+		t.Errorf("queue = %v", got)
+	}
+}
+
+func TestRotationStatePersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rotation.json")
+
+	st, err := LoadRotation(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.Team("backend").Record([]string{"alice"}, time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC))
+	if err := st.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err = LoadRotation(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr := st.Team("backend"); tr.Assigned["alice"] != 1 || tr.LastAt["alice"].IsZero() {
+		t.Errorf("unexpected state %+v", tr)
+	}
+}
@@ -0,0 +1,228 @@
+package reviewers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+// ErrNoCodeOwners is returned when the project has no CODEOWNERS file.
+var ErrNoCodeOwners = errors.New("no CODEOWNERS file")
+
+// loadLimit caps the open MRs counted per reviewer.
+const loadLimit = 100
+
+// LoadClient is the subset of gitlab.Client methods needed to measure
+// review load.
+type LoadClient interface {
+	ListMRs(opts gitlab.ListMROptions) ([]gitlab.MergeRequest, error)
+}
+
+// Client is the subset of gitlab.Client methods needed to suggest reviewers.
+type Client interface {
+	LoadClient
+	GetMRDiffs(projectID, iid int) ([]gitlab.MRDiff, error)
+	GetRawFile(projectID, filePath, ref string) ([]byte, error)
+	GetUserByUsername(username string) (*gitlab.User, error)
+	ListGroupMembers(groupID string, search string) ([]gitlab.User, error)
+	GetUserStatus(userID int) (*gitlab.UserStatus, error)
+}
+
+// ReviewLoad returns the number of open MRs the user is a reviewer of,
+// not counting the MR with ID exceptMRID.
+func ReviewLoad(client LoadClient, userID, exceptMRID int) (int, error) {
+	mrs, err := client.ListMRs(gitlab.ListMROptions{State: "opened", ReviewerID: userID, PerPage: loadLimit})
+	if err != nil {
+		return 0, err
+	}
+	load := 0
+	for _, m := range mrs {
+		if m.ID != exceptMRID {
+			load++
+		}
+	}
+	return load, nil
+}
+
+// Candidate is a code owner of the MR's changes.
+type Candidate struct {
+	User     gitlab.User
+	Files    int     // changed files the user owns
+	Load     int     // other open MRs the user is reviewing
+	Busy     bool    // status is busy or signals absence
+	Status   string  // status emoji and message
+	Reviewer bool    // already a reviewer of the MR
+	Score    float64 // Files / (1 + Load)
+}
+
+// LoadCodeOwners reads the first CODEOWNERS file found on ref and returns it
+// with its path. Only missing files are skipped; other errors are returned.
+func LoadCodeOwners(client Client, projectID int, ref string) (*CodeOwners, string, error) {
+	for _, path := range CodeOwnersPaths {
+		content, err := client.GetRawFile(strconv.Itoa(projectID), path, ref)
+		var apiErr *gitlab.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return ParseCodeOwners(string(content)), path, nil
+	}
+	return nil, "", ErrNoCodeOwners
+}
+
+// Suggest ranks the code owners of the MR's changed files: available users
+// before busy ones, then by score. The MR author is never suggested. Owners
+// given by email cannot be looked up and are skipped.
+func Suggest(client Client, mr *gitlab.MergeRequest) ([]Candidate, error) {
+	owners, _, err := LoadCodeOwners(client, mr.ProjectID, mr.TargetBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := client.GetMRDiffs(mr.ProjectID, mr.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{client: client, users: make(map[string][]gitlab.User)}
+	files := make(map[int]int)
+	byID := make(map[int]gitlab.User)
+	for _, d := range diffs {
+		owned := make(map[int]bool)
+		for _, path := range diffPaths(d) {
+			for _, ref := range owners.Owners(path) {
+				for _, u := range r.resolve(ref) {
+					owned[u.ID] = true
+					byID[u.ID] = u
+				}
+			}
+		}
+		for id := range owned {
+			files[id]++
+		}
+	}
+
+	reviewing := make(map[int]bool)
+	for _, u := range mr.Reviewers {
+		reviewing[u.ID] = true
+	}
+
+	var candidates []Candidate
+	for id, u := range byID {
+		if id == mr.Author.ID {
+			continue
+		}
+		c := Candidate{User: u, Files: files[id], Reviewer: reviewing[id]}
+
+		if c.Load, err = ReviewLoad(client, id, mr.ID); err != nil {
+			return nil, fmt.Errorf("review load of %s: %w", u.Username, err)
+		}
+
+		// A missing status is not worth failing the suggestion for
+		if status, err := client.GetUserStatus(id); err == nil {
+			c.Busy = isAway(status)
+			c.Status = strings.TrimSpace(formatStatus(status))
+		}
+
+		c.Score = float64(c.Files) / float64(1+c.Load)
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Busy != b.Busy {
+			return b.Busy
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.User.Username < b.User.Username
+	})
+
+	return candidates, nil
+}
+
+// Pick returns the first n candidates that are available and not already
+// reviewing the MR.
+func Pick(candidates []Candidate, n int) []Candidate {
+	if n <= 0 {
+		return nil
+	}
+	var picked []Candidate
+	for _, c := range candidates {
+		if len(picked) == n {
+			break
+		}
+		if !c.Busy && !c.Reviewer {
+			picked = append(picked, c)
+		}
+	}
+	return picked
+}
+
+// diffPaths returns the paths a diff touches: both the old and the new path
+// of a renamed file.
+func diffPaths(d gitlab.MRDiff) []string {
+	if d.OldPath != "" && d.OldPath != d.NewPath {
+		return []string{d.OldPath, d.NewPath}
+	}
+	return []string{d.NewPath}
+}
+
+// resolver turns owner references into users, caching the lookups.
+type resolver struct {
+	client Client
+	users  map[string][]gitlab.User
+}
+
+// resolve returns the user named by "@username" or the members of the group
+// named by "@group/path". Unknown references and emails yield no users.
+func (r *resolver) resolve(ref string) []gitlab.User {
+	if !strings.HasPrefix(ref, "@") {
+		return nil
+	}
+	name := strings.ToLower(strings.TrimPrefix(ref, "@"))
+	if users, ok := r.users[name]; ok {
+		return users
+	}
+
+	var users []gitlab.User
+	if !strings.Contains(name, "/") {
+		if u, err := r.client.GetUserByUsername(name); err == nil {
+			users = []gitlab.User{*u}
+		}
+	}
+	if users == nil {
+		users, _ = r.client.ListGroupMembers(name, "")
+	}
+
+	r.users[name] = users
+	return users
+}
+
+// awayRe marks a status as out of office even when availability is not set
+// to busy.
+var awayRe = regexp.MustCompile(`(?i)\b(palm_tree|ooo|out of office|vacation|holidays?|sick|parental leave|away)\b`)
+
+func isAway(status *gitlab.UserStatus) bool {
+	return status.Availability == "busy" || awayRe.MatchString(status.Emoji+" "+status.Message)
+}
+
+func formatStatus(status *gitlab.UserStatus) string {
+	s := status.Message
+	if status.Emoji != "" {
+		s = ":" + status.Emoji + ": " + s
+	}
+	if status.Availability == "busy" {
+		s = "busy " + s
+	}
+	return s
+}
@@ -0,0 +1,154 @@
+package reviewers
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+type fakeClient struct {
+	files    map[string]string
+	diffs    []gitlab.MRDiff
+	users    map[string]gitlab.User
+	groups   map[string][]gitlab.User
+	load     map[int]int
+	statuses map[int]gitlab.UserStatus
+	fileErr  error
+}
+
+func (f *fakeClient) GetMRDiffs(projectID, iid int) ([]gitlab.MRDiff, error) {
+	return f.diffs, nil
+}
+
+func (f *fakeClient) GetRawFile(projectID, filePath, ref string) ([]byte, error) {
+	if f.fileErr != nil {
+		return nil, f.fileErr
+	}
+	content, ok := f.files[filePath]
+	if !ok {
+		return nil, &gitlab.APIError{StatusCode: http.StatusNotFound, Body: `{"message":"404 File Not Found"}`}
+	}
+	return []byte(content), nil
+}
+
+func (f *fakeClient) GetUserByUsername(username string) (*gitlab.User, error) {
+	u, ok := f.users[username]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return &u, nil
+}
+
+func (f *fakeClient) ListGroupMembers(groupID string, search string) ([]gitlab.User, error) {
+	members, ok := f.groups[groupID]
+	if !ok {
+		return nil, errors.New("404 group not found")
+	}
+	return members, nil
+}
+
+func (f *fakeClient) ListMRs(opts gitlab.ListMROptions) ([]gitlab.MergeRequest, error) {
+	mrs := make([]gitlab.MergeRequest, f.load[opts.ReviewerID])
+	for i := range mrs {
+		mrs[i].ID = 1000 + i
+	}
+	return mrs, nil
+}
+
+func (f *fakeClient) GetUserStatus(userID int) (*gitlab.UserStatus, error) {
+	s := f.statuses[userID]
+	return &s, nil
+}
+
+func newFakeClient() *fakeClient {
+	alice := gitlab.User{ID: 1, Username: "alice"}
+	bob := gitlab.User{ID: 2, Username: "bob"}
+	carol := gitlab.User{ID: 3, Username: "carol"}
+	dave := gitlab.User{ID: 4, Username: "dave"}
+	return &fakeClient{
+		files: map[string]string{
+			".gitlab/CODEOWNERS": "* @alice\n/api/ @bob @backend/core\n/web/ @dave\n",
+		},
+		diffs: []gitlab.MRDiff{
+			{OldPath: "api/a.go", NewPath: "api/a.go"},
+			{OldPath: "api/b.go", NewPath: "api/b.go"},
+			{OldPath: "README.md", NewPath: "README.md"},
+			{OldPath: "web/old.js", NewPath: "web/new.js", RenamedFile: true},
+		},
+		users:  map[string]gitlab.User{"alice": alice, "bob": bob, "carol": carol, "dave": dave},
+		groups: map[string][]gitlab.User{"backend/core": {bob, carol}},
+		load:   map[int]int{2: 3},
+		statuses: map[int]gitlab.UserStatus{
+			4: {Emoji: "palm_tree", Message: "Back on Monday"},
+		},
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	client := newFakeClient()
+	mr := &gitlab.MergeRequest{ID: 99, IID: 7, ProjectID: 5, Author: gitlab.User{ID: 1}}
+
+	candidates, err := Suggest(client, mr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// alice is the author; carol owns two files without load, bob owns two
+	// with a load of three, dave owns the renamed file but is away
+	var got []string
+	for _, c := range candidates {
+		got = append(got, c.User.Username)
+	}
+	want := []string{"carol", "bob", "dave"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("candidates = %v, want %v", got, want)
+	}
+
+	if c := candidates[1]; c.Files != 2 || c.Load != 3 || c.Score != 0.5 {
+		t.Errorf("bob = %+v", c)
+	}
+	if c := candidates[2]; !c.Busy || c.Files != 1 || c.Status != ":palm_tree: Back on Monday" {
+		t.Errorf("dave = %+v", c)
+	}
+}
+
+func TestSuggestNoCodeOwners(t *testing.T) {
+	client := newFakeClient()
+	client.files = nil
+
+	_, err := Suggest(client, &gitlab.MergeRequest{ProjectID: 5, IID: 7})
+	if !errors.Is(err, ErrNoCodeOwners) {
+		t.Errorf("err = %v, want ErrNoCodeOwners", err)
+	}
+}
+
+func TestLoadCodeOwnersError(t *testing.T) {
+	client := newFakeClient()
+	client.fileErr = &gitlab.APIError{StatusCode: http.StatusForbidden, Body: `{"message":"403 Forbidden"}`}
+
+	// A file that cannot be read is not a missing file
+	_, _, err := LoadCodeOwners(client, 5, "main")
+	if err == nil || errors.Is(err, ErrNoCodeOwners) {
+		t.Errorf("err = %v, want the API error", err)
+	}
+}
+
+func TestPick(t *testing.T) {
+	candidates := []Candidate{
+		{User: gitlab.User{Username: "a"}, Reviewer: true},
+		{User: gitlab.User{Username: "b"}},
+		{User: gitlab.User{Username: "c"}},
+		{User: gitlab.User{Username: "d"}, Busy: true},
+	}
+
+	picked := Pick(candidates, 3)
+	if len(picked) != 2 || picked[0].User.Username != "b" || picked[1].User.Username != "c" {
+		t.Errorf("Pick = %+v", picked)
+	}
+	if picked := Pick(candidates, -1); len(picked) != 0 {
+		t.Errorf("Pick(-1) = %+v, want none", picked)
+	}
+}
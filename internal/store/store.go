@@ -0,0 +1,320 @@
+// Package store keeps a local history of activity entries so that past
+// ranges can be queried offline and beyond GitLab's event retention.
+//
+// Records are kept as JSON lines in one file per UTC month, next to a state
+// file holding the sync cursor:
+//
+//	<dir>/2024-05.jsonl
+//	<dir>/state.json
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+const (
+	stateFile  = "state.json"
+	fileSuffix = ".jsonl"
+	monthFmt   = "2006-01"
+)
+
+// Record is a stored activity entry with the event it was built from.
+type Record struct {
+	EventID   int                  `json:"event_id"`
+	CreatedAt time.Time            `json:"created_at"`
+	Entry     gitlab.ActivityEntry `json:"entry"`
+}
+
+// State is the sync cursor: the newest event stored so far.
+type State struct {
+	LastEventID int       `json:"last_event_id"`
+	LastEventAt time.Time `json:"last_event_at"`
+	SyncedAt    time.Time `json:"synced_at"`
+}
+
+// Store is a directory of monthly record files.
+type Store struct {
+	dir string
+}
+
+// Open returns the store in dir, creating the directory if needed.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating store directory: %w", err)
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// State returns the sync cursor; it is zero before the first sync.
+func (s *Store) State() (State, error) {
+	var st State
+	data, err := os.ReadFile(filepath.Join(s.dir, stateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return st, nil
+	}
+	if err != nil {
+		return st, fmt.Errorf("reading store state: %w", err)
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, fmt.Errorf("reading store state: %w", err)
+	}
+	return st, nil
+}
+
+// SaveState replaces the sync cursor.
+func (s *Store) SaveState(st State) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeAtomic(filepath.Join(s.dir, stateFile), data)
+}
+
+// Add stores records, skipping events that are already stored, and returns
+// the number added.
+func (s *Store) Add(records []Record) (int, error) {
+	byMonth := make(map[string][]Record)
+	for _, r := range records {
+		m := r.CreatedAt.UTC().Format(monthFmt)
+		byMonth[m] = append(byMonth[m], r)
+	}
+
+	added := 0
+	for month, recs := range byMonth {
+		existing, err := s.readMonth(month)
+		if err != nil {
+			return added, err
+		}
+		seen := make(map[int]bool, len(existing))
+		for _, r := range existing {
+			seen[r.EventID] = true
+		}
+		n := len(existing)
+		for _, r := range recs {
+			if !seen[r.EventID] {
+				seen[r.EventID] = true
+				existing = append(existing, r)
+			}
+		}
+		if len(existing) == n {
+			continue
+		}
+		if err := s.writeMonth(month, existing); err != nil {
+			return added, err
+		}
+		added += len(existing) - n
+	}
+	return added, nil
+}
+
+// Query returns the records created in [from, to), newest first. A zero
+// bound leaves the range open on that side.
+func (s *Store) Query(from, to time.Time) ([]Record, error) {
+	months, err := s.months()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Record
+	for _, month := range months {
+		start, _ := time.Parse(monthFmt, month)
+		if !to.IsZero() && !start.Before(to) {
+			continue
+		}
+		if !from.IsZero() && !start.AddDate(0, 1, 0).After(from) {
+			continue
+		}
+		recs, err := s.readMonth(month)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range recs {
+			if !from.IsZero() && r.CreatedAt.Before(from) {
+				continue
+			}
+			if !to.IsZero() && !r.CreatedAt.Before(to) {
+				continue
+			}
+			result = append(result, r)
+		}
+	}
+
+	sortRecords(result)
+	return result, nil
+}
+
+// Prune removes the records created before t and returns how many were
+// removed.
+func (s *Store) Prune(before time.Time) (int, error) {
+	months, err := s.months()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, month := range months {
+		start, _ := time.Parse(monthFmt, month)
+		if !start.Before(before) {
+			continue
+		}
+		recs, err := s.readMonth(month)
+		if err != nil {
+			return removed, err
+		}
+		kept := recs[:0]
+		for _, r := range recs {
+			if !r.CreatedAt.Before(before) {
+				kept = append(kept, r)
+			}
+		}
+		removed += len(recs) - len(kept)
+		if len(kept) == 0 {
+			if err := os.Remove(s.monthPath(month)); err != nil {
+				return removed, fmt.Errorf("removing %s: %w", month, err)
+			}
+			continue
+		}
+		if len(kept) < len(recs) {
+			if err := s.writeMonth(month, kept); err != nil {
+				return removed, err
+			}
+		}
+	}
+	return removed, nil
+}
+
+// Count returns the number of stored records and the oldest one's time.
+func (s *Store) Count() (int, time.Time, error) {
+	months, err := s.months()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	count := 0
+	var oldest time.Time
+	for _, month := range months {
+		recs, err := s.readMonth(month)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		count += len(recs)
+		for _, r := range recs {
+			if oldest.IsZero() || r.CreatedAt.Before(oldest) {
+				oldest = r.CreatedAt
+			}
+		}
+	}
+	return count, oldest, nil
+}
+
+// months lists the stored months in ascending order.
+func (s *Store) months() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading store: %w", err)
+	}
+	var months []string
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), fileSuffix)
+		if e.IsDir() || name == e.Name() {
+			continue
+		}
+		if _, err := time.Parse(monthFmt, name); err == nil {
+			months = append(months, name)
+		}
+	}
+	sort.Strings(months)
+	return months, nil
+}
+
+func (s *Store) monthPath(month string) string {
+	return filepath.Join(s.dir, month+fileSuffix)
+}
+
+func (s *Store) readMonth(month string) ([]Record, error) {
+	f, err := os.Open(s.monthPath(month))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", month, err)
+	}
+	defer f.Close()
+
+	var recs []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", month, err)
+		}
+		recs = append(recs, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", month, err)
+	}
+	return recs, nil
+}
+
+func (s *Store) writeMonth(month string, recs []Record) error {
+	sortRecords(recs)
+	var b strings.Builder
+	for _, r := range recs {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	return writeAtomic(s.monthPath(month), []byte(b.String()))
+}
+
+// sortRecords orders records newest first, like the events API.
+func sortRecords(recs []Record) {
+	sort.SliceStable(recs, func(i, j int) bool {
+		if !recs[i].CreatedAt.Equal(recs[j].CreatedAt) {
+			return recs[i].CreatedAt.After(recs[j].CreatedAt)
+		}
+		return recs[i].EventID > recs[j].EventID
+	})
+}
+
+// writeAtomic replaces path so that readers never see a partial file.
+func writeAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", filepath.Base(path), err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", filepath.Base(path), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", filepath.Base(path), err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("writing %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
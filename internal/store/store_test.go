@@ -0,0 +1,112 @@
+package store
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+func record(id int, at string) Record {
+	t, _ := time.Parse(time.RFC3339, at)
+	return Record{EventID: id, CreatedAt: t, Entry: gitlab.ActivityEntry{Type: "pushed to", Project: "api"}}
+}
+
+func ids(recs []Record) []int {
+	var result []int
+	for _, r := range recs {
+		result = append(result, r.EventID)
+	}
+	return result
+}
+
+func TestAddAndQuery(t *testing.T) {
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := s.Add([]Record{
+		record(1, "2024-04-30T23:30:00Z"),
+		record(2, "2024-05-01T08:00:00Z"),
+		record(3, "2024-05-20T08:00:00Z"),
+	})
+	if err != nil || n != 3 {
+		t.Fatalf("Add = %d, %v", n, err)
+	}
+
+	// Already stored events are skipped
+	n, err = s.Add([]Record{record(3, "2024-05-20T08:00:00Z"), record(4, "2024-06-02T08:00:00Z")})
+	if err != nil || n != 1 {
+		t.Fatalf("second Add = %d, %v", n, err)
+	}
+
+	all, err := s.Query(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(all); !slices.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("Query all = %v, want [4 3 2 1]", got)
+	}
+
+	// May 1st in UTC+2 starts at 22:00 UTC on April 30th
+	loc := time.FixedZone("UTC+2", 2*3600)
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, loc)
+	may, err := s.Query(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(may); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("Query May = %v, want [3 2 1]", got)
+	}
+}
+
+func TestState(t *testing.T) {
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := s.State()
+	if err != nil || st.LastEventID != 0 {
+		t.Fatalf("initial state = %+v, %v", st, err)
+	}
+
+	want := State{LastEventID: 42, LastEventAt: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+	if err := s.SaveState(want); err != nil {
+		t.Fatal(err)
+	}
+	st, err = s.State()
+	if err != nil || st.LastEventID != 42 || !st.LastEventAt.Equal(want.LastEventAt) {
+		t.Errorf("state = %+v, %v", st, err)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Add([]Record{
+		record(1, "2024-03-10T08:00:00Z"),
+		record(2, "2024-04-05T08:00:00Z"),
+		record(3, "2024-04-20T08:00:00Z"),
+		record(4, "2024-05-01T08:00:00Z"),
+	})
+
+	removed, err := s.Prune(time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC))
+	if err != nil || removed != 2 {
+		t.Fatalf("Prune = %d, %v", removed, err)
+	}
+
+	months, _ := s.months()
+	if len(months) != 2 || months[0] != "2024-04" {
+		t.Errorf("months after prune = %v, want [2024-04 2024-05]", months)
+	}
+	count, oldest, err := s.Count()
+	if err != nil || count != 2 || oldest.Day() != 20 {
+		t.Errorf("Count = %d, %s, %v", count, oldest, err)
+	}
+}
@@ -0,0 +1,213 @@
+// Package task finds task references such as #12345 or ABC-123 in branch
+// names, titles and commit messages.
+//
+// Patterns are regular expressions with named groups. The reference a
+// pattern yields is the "task" group when present, otherwise "#" followed by
+// the "id" group, otherwise the whole match. Numeric lookups (resolving an
+// MR from a task number) compare against the "id" group.
+//
+// Patterns are configured per scope: "default", a group path, a project
+// path, or a project ID. A project uses the most specific scope that defines
+// patterns of the kind needed, walking up its namespace to "default".
+package task
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/user/gitlab-cli/internal/config"
+)
+
+// DefaultScope is the scope that applies when no other scope matches.
+const DefaultScope = "default"
+
+// DefaultPatterns find five-digit task numbers: "12345-desc" or
+// "feature/12345-desc" in branch names and "#12345" anywhere.
+var DefaultPatterns = config.TaskPatterns{
+	Branch: []string{`(?:^|/)(?P<id>\d{5})-`},
+	Text:   []string{`#(?P<id>\d{5})`},
+}
+
+type rules struct {
+	branch []*regexp.Regexp
+	text   []*regexp.Regexp
+}
+
+// Matcher extracts task references using the patterns of a config. A nil
+// Matcher uses DefaultPatterns.
+type Matcher struct {
+	scopes map[string]rules
+}
+
+var defaultMatcher = DefaultMatcher()
+
+// NewMatcher compiles the patterns of every scope. DefaultPatterns apply
+// unless the "default" scope overrides them.
+func NewMatcher(scopes map[string]config.TaskPatterns) (*Matcher, error) {
+	m := &Matcher{scopes: make(map[string]rules)}
+
+	def, err := compile(DefaultScope, DefaultPatterns)
+	if err != nil {
+		return nil, err
+	}
+	m.scopes[DefaultScope] = def
+
+	for scope, patterns := range scopes {
+		r, err := compile(scope, patterns)
+		if err != nil {
+			return nil, err
+		}
+		key := normalizeScope(scope)
+		if key == DefaultScope {
+			if len(r.branch) > 0 {
+				def.branch = r.branch
+			}
+			if len(r.text) > 0 {
+				def.text = r.text
+			}
+			m.scopes[DefaultScope] = def
+			continue
+		}
+		m.scopes[key] = r
+	}
+
+	return m, nil
+}
+
+// DefaultMatcher returns a matcher with only DefaultPatterns.
+func DefaultMatcher() *Matcher {
+	m, err := NewMatcher(nil)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func compile(scope string, patterns config.TaskPatterns) (rules, error) {
+	var r rules
+	for _, list := range []struct {
+		src []string
+		dst *[]*regexp.Regexp
+	}{
+		{patterns.Branch, &r.branch},
+		{patterns.Text, &r.text},
+	} {
+		for _, p := range list.src {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return rules{}, fmt.Errorf("task pattern %q of %s: %w", p, scope, err)
+			}
+			*list.dst = append(*list.dst, re)
+		}
+	}
+	return r, nil
+}
+
+// normalizeScope lowercases a scope key; config keys are case-insensitive.
+func normalizeScope(scope string) string {
+	return strings.ToLower(strings.Trim(scope, "/"))
+}
+
+// lookup returns the patterns of the given kind for a project, which is a
+// path ("group/sub/repo"), a project ID, or empty.
+func (m *Matcher) lookup(project string, kind func(rules) []*regexp.Regexp) []*regexp.Regexp {
+	if m == nil {
+		m = defaultMatcher
+	}
+	scope := normalizeScope(project)
+	for scope != "" {
+		if r, ok := m.scopes[scope]; ok && len(kind(r)) > 0 {
+			return kind(r)
+		}
+		i := strings.LastIndex(scope, "/")
+		if i < 0 {
+			break
+		}
+		scope = scope[:i]
+	}
+	return kind(m.scopes[DefaultScope])
+}
+
+func branchRules(r rules) []*regexp.Regexp { return r.branch }
+func textRules(r rules) []*regexp.Regexp   { return r.text }
+
+// FromBranch returns the task of a branch name, trying the branch patterns
+// before the text patterns. It returns "" when nothing matches.
+func (m *Matcher) FromBranch(project, branch string) string {
+	if t := first(m.lookup(project, branchRules), branch); t != "" {
+		return t
+	}
+	return m.FromText(project, branch)
+}
+
+// FromText returns the first task referenced in s, or "".
+func (m *Matcher) FromText(project, s string) string {
+	return first(m.lookup(project, textRules), s)
+}
+
+// Mentions reports whether s references the task with the given id. The id
+// must not run on into further letters or digits, so #12345 does not match
+// #12345x.
+func (m *Matcher) Mentions(project, s, id string) bool {
+	for _, re := range m.lookup(project, textRules) {
+		idx := re.SubexpIndex("id")
+		if idx < 0 {
+			continue
+		}
+		for _, loc := range re.FindAllStringSubmatchIndex(s, -1) {
+			start, end := loc[2*idx], loc[2*idx+1]
+			if start < 0 || s[start:end] != id {
+				continue
+			}
+			if end == len(s) || !isAlnum(s[end]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsReference reports whether s as a whole is a task reference, such as
+// ABC-123, under the patterns of any scope.
+func (m *Matcher) IsReference(s string) bool {
+	if m == nil {
+		m = defaultMatcher
+	}
+	for _, r := range m.scopes {
+		if s != "" && (first(r.branch, s) == s || first(r.text, s) == s) {
+			return true
+		}
+	}
+	return false
+}
+
+func first(res []*regexp.Regexp, s string) string {
+	for _, re := range res {
+		match := re.FindStringSubmatch(s)
+		if match == nil {
+			continue
+		}
+		if i := re.SubexpIndex("task"); i >= 0 && match[i] != "" {
+			return match[i]
+		}
+		if i := re.SubexpIndex("id"); i >= 0 && match[i] != "" {
+			return "#" + match[i]
+		}
+		return match[0]
+	}
+	return ""
+}
+
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// ProjectFromReference returns the project path of a full MR or issue
+// reference such as "group/repo!12" or "group/repo#34".
+func ProjectFromReference(full string) string {
+	if i := strings.LastIndexAny(full, "!#"); i >= 0 {
+		return full[:i]
+	}
+	return full
+}
@@ -0,0 +1,151 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/user/gitlab-cli/internal/config"
+)
+
+func TestDefaultMatcher(t *testing.T) {
+	m := DefaultMatcher()
+
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"12345-fix-login", "#12345"},
+		{"feature/12345-fix-login", "#12345"},
+		{"fix-#54321", "#54321"},
+		{"1234-too-short", ""},
+		{"main", ""},
+	}
+
+	for _, tt := range tests {
+		if got := m.FromBranch("", tt.branch); got != tt.want {
+			t.Errorf("FromBranch(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+
+	if got := m.FromText("", "Fix #12345: login"); got != "#12345" {
+		t.Errorf("FromText = %q, want #12345", got)
+	}
+}
+
+func TestScopes(t *testing.T) {
+	m, err := NewMatcher(map[string]config.TaskPatterns{
+		"Acme": {
+			Branch: []string{`^(?P<task>[A-Z]+-(?P<id>\d+))`},
+			Text:   []string{`(?P<task>[A-Z]+-(?P<id>\d+))`},
+		},
+		"acme/legacy": {
+			Text: []string{`#(?P<id>\d{6})`},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		project, branch, want string
+	}{
+		{"acme/api", "ABC-123-new-endpoint", "ABC-123"},
+		{"acme/sub/api", "ABC-7", "ABC-7"},
+		// Branch patterns are inherited from acme, text patterns are not
+		{"acme/legacy", "ABC-9-cleanup", "ABC-9"},
+		{"acme/legacy", "fix-#123456", "#123456"},
+		{"other/api", "12345-fix", "#12345"},
+		{"other/api", "ABC-123-new-endpoint", ""},
+		{"", "12345-fix", "#12345"},
+	}
+
+	for _, tt := range tests {
+		if got := m.FromBranch(tt.project, tt.branch); got != tt.want {
+			t.Errorf("FromBranch(%q, %q) = %q, want %q", tt.project, tt.branch, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultScopeOverride(t *testing.T) {
+	m, err := NewMatcher(map[string]config.TaskPatterns{
+		"default": {Text: []string{`#(?P<id>\d{6})`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := m.FromText("any/project", "Fix #123456"); got != "#123456" {
+		t.Errorf("FromText = %q, want #123456", got)
+	}
+	// Branch patterns keep the built-in default
+	if got := m.FromBranch("any/project", "12345-fix"); got != "#12345" {
+		t.Errorf("FromBranch = %q, want #12345", got)
+	}
+}
+
+func TestMentions(t *testing.T) {
+	var m *Matcher
+
+	tests := []struct {
+		s    string
+		id   string
+		want bool
+	}{
+		{"Fix #12345", "12345", true},
+		{"Fix #12345: login", "12345", true},
+		{"Fix #12345x", "12345", false},
+		{"Fix #123456", "12345", false},
+		{"Fix #54321", "12345", false},
+		{"Fix #54321 and #12345", "12345", true},
+	}
+
+	for _, tt := range tests {
+		if got := m.Mentions("", tt.s, tt.id); got != tt.want {
+			t.Errorf("Mentions(%q, %q) = %v, want %v", tt.s, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestNewMatcherInvalidPattern(t *testing.T) {
+	_, err := NewMatcher(map[string]config.TaskPatterns{
+		"acme": {Branch: []string{`(?P<id>\d+`}},
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid pattern")
+	}
+}
+
+func TestIsReference(t *testing.T) {
+	m, err := NewMatcher(map[string]config.TaskPatterns{
+		"acme": {Text: []string{`(?P<task>ABC-\d+)`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]bool{
+		"ABC-123":     true,
+		"ABC-123 fix": false,
+		"12345":       false,
+		"#12345":      true,
+		"":            false,
+	}
+	for s, want := range tests {
+		if got := m.IsReference(s); got != want {
+			t.Errorf("IsReference(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestProjectFromReference(t *testing.T) {
+	tests := map[string]string{
+		"group/repo!12":  "group/repo",
+		"group/sub/r#34": "group/sub/r",
+		"no-reference":   "no-reference",
+		"":               "",
+	}
+	for in, want := range tests {
+		if got := ProjectFromReference(in); got != want {
+			t.Errorf("ProjectFromReference(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
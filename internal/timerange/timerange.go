@@ -0,0 +1,142 @@
+// Package timerange computes date ranges such as "this week" or "the last two
+// weeks" with day boundaries in a given time zone.
+package timerange
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Range is a span of whole days. From is the start of the first day and To
+// the start of the day after the last one; either is zero when the range is
+// open on that side.
+type Range struct {
+	From time.Time
+	To   time.Time
+}
+
+// Contains reports whether t falls within the range.
+func (r Range) Contains(t time.Time) bool {
+	if !r.From.IsZero() && t.Before(r.From) {
+		return false
+	}
+	if !r.To.IsZero() && !t.Before(r.To) {
+		return false
+	}
+	return true
+}
+
+// FromDate returns the first day as YYYY-MM-DD, or "" when open.
+func (r Range) FromDate() string {
+	if r.From.IsZero() {
+		return ""
+	}
+	return r.From.Format("2006-01-02")
+}
+
+// ToDate returns the last day as YYYY-MM-DD, or "" when open.
+func (r Range) ToDate() string {
+	if r.To.IsZero() {
+		return ""
+	}
+	return r.To.AddDate(0, 0, -1).Format("2006-01-02")
+}
+
+// APIBounds returns after and before dates for GitLab APIs that filter by
+// UTC date and exclude both bounds. They cover the whole range whatever its
+// zone; callers filter the results with Contains.
+func (r Range) APIBounds() (after, before string) {
+	if !r.From.IsZero() {
+		after = r.From.UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	if !r.To.IsZero() {
+		before = r.To.UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	}
+	return after, before
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// Day returns the day containing t, in t's location.
+func Day(t time.Time) Range {
+	from := startOfDay(t)
+	return Range{From: from, To: from.AddDate(0, 0, 1)}
+}
+
+// Month returns the month containing now, shifted by offset months.
+func Month(now time.Time, offset int) Range {
+	y, m, _ := now.Date()
+	from := time.Date(y, m+time.Month(offset), 1, 0, 0, 0, 0, now.Location())
+	return Range{From: from, To: from.AddDate(0, 1, 0)}
+}
+
+// Week returns the ISO week (Monday to Sunday) containing now, shifted by
+// offset weeks.
+func Week(now time.Time, offset int) Range {
+	day := startOfDay(now)
+	monday := day.AddDate(0, 0, -((int(day.Weekday())+6)%7)+7*offset)
+	return Range{From: monday, To: monday.AddDate(0, 0, 7)}
+}
+
+// Quarter returns the calendar quarter containing now, shifted by offset
+// quarters.
+func Quarter(now time.Time, offset int) Range {
+	y, m, _ := now.Date()
+	first := time.Month((int(m)-1)/3*3 + 1)
+	from := time.Date(y, first+time.Month(3*offset), 1, 0, 0, 0, 0, now.Location())
+	return Range{From: from, To: from.AddDate(0, 3, 0)}
+}
+
+var sinceRe = regexp.MustCompile(`^(\d+)([dwmy])$`)
+
+// Since returns the range from spec ago until the end of today. spec is a
+// number followed by d (days), w (weeks), m (months) or y (years), e.g. "2w".
+func Since(now time.Time, spec string) (Range, error) {
+	match := sinceRe.FindStringSubmatch(spec)
+	if match == nil {
+		return Range{}, fmt.Errorf("invalid --since '%s' (use e.g. 10d, 2w, 3m, 1y)", spec)
+	}
+	n, _ := strconv.Atoi(match[1])
+
+	today := startOfDay(now)
+	var from time.Time
+	switch match[2] {
+	case "d":
+		from = today.AddDate(0, 0, -n)
+	case "w":
+		from = today.AddDate(0, 0, -7*n)
+	case "m":
+		from = today.AddDate(0, -n, 0)
+	case "y":
+		from = today.AddDate(-n, 0, 0)
+	}
+	return Range{From: from, To: today.AddDate(0, 0, 1)}, nil
+}
+
+var isoWeekRe = regexp.MustCompile(`^(\d{4})-?W(\d{2})$`)
+
+// Parse parses a day (YYYY-MM-DD) or an ISO week (YYYY-Www) in loc.
+func Parse(s string, loc *time.Location) (Range, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, loc); err == nil {
+		return Day(t), nil
+	}
+
+	match := isoWeekRe.FindStringSubmatch(s)
+	if match == nil {
+		return Range{}, fmt.Errorf("invalid date '%s' (use YYYY-MM-DD or YYYY-Www)", s)
+	}
+	year, _ := strconv.Atoi(match[1])
+	week, _ := strconv.Atoi(match[2])
+
+	// January 4th is always in week 1
+	r := Week(time.Date(year, time.January, 4, 0, 0, 0, 0, loc), week-1)
+	if y, w := r.From.ISOWeek(); y != year || w != week {
+		return Range{}, fmt.Errorf("invalid date '%s': %d has no week %d", s, year, week)
+	}
+	return r, nil
+}
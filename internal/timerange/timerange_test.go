@@ -0,0 +1,106 @@
+package timerange
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRanges(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Prague")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	// Thursday
+	now := time.Date(2024, 5, 2, 0, 30, 0, 0, loc)
+
+	tests := []struct {
+		name     string
+		r        Range
+		from, to string
+	}{
+		{"month", Month(now, 0), "2024-05-01", "2024-05-31"},
+		{"previous month", Month(now, -1), "2024-04-01", "2024-04-30"},
+		{"previous month across year", Month(time.Date(2024, 1, 15, 0, 0, 0, 0, loc), -1), "2023-12-01", "2023-12-31"},
+		{"week", Week(now, 0), "2024-04-29", "2024-05-05"},
+		{"last week", Week(now, -1), "2024-04-22", "2024-04-28"},
+		{"week from sunday", Week(time.Date(2024, 5, 5, 23, 0, 0, 0, loc), 0), "2024-04-29", "2024-05-05"},
+		{"quarter", Quarter(now, 0), "2024-04-01", "2024-06-30"},
+		{"previous quarter", Quarter(now, -1), "2024-01-01", "2024-03-31"},
+	}
+
+	for _, tt := range tests {
+		if tt.r.FromDate() != tt.from || tt.r.ToDate() != tt.to {
+			t.Errorf("%s = %s..%s, want %s..%s", tt.name, tt.r.FromDate(), tt.r.ToDate(), tt.from, tt.to)
+		}
+	}
+}
+
+func TestSince(t *testing.T) {
+	now := time.Date(2024, 5, 15, 18, 0, 0, 0, time.UTC)
+
+	r, err := Since(now, "2w")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.FromDate() != "2024-05-01" || r.ToDate() != "2024-05-15" {
+		t.Errorf("2w = %s..%s", r.FromDate(), r.ToDate())
+	}
+
+	r, _ = Since(now, "3m")
+	if r.FromDate() != "2024-02-15" {
+		t.Errorf("3m starts %s", r.FromDate())
+	}
+
+	if _, err := Since(now, "2 weeks"); err == nil {
+		t.Error("expected error for invalid spec")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		from, to string
+	}{
+		{"2024-05-02", "2024-05-02", "2024-05-02"},
+		{"2024-W18", "2024-04-29", "2024-05-05"},
+		{"2024W01", "2024-01-01", "2024-01-07"},
+		{"2021-W01", "2021-01-04", "2021-01-10"},
+		{"2020-W53", "2020-12-28", "2021-01-03"},
+	}
+
+	for _, tt := range tests {
+		r, err := Parse(tt.in, time.UTC)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if r.FromDate() != tt.from || r.ToDate() != tt.to {
+			t.Errorf("Parse(%q) = %s..%s, want %s..%s", tt.in, r.FromDate(), r.ToDate(), tt.from, tt.to)
+		}
+	}
+
+	for _, in := range []string{"2021-W53", "2024-13-01", "yesterday"} {
+		if _, err := Parse(in, time.UTC); err == nil {
+			t.Errorf("Parse(%q) should fail", in)
+		}
+	}
+}
+
+func TestContainsAndAPIBounds(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*3600)
+	r, _ := Parse("2024-05-02", loc)
+
+	// 23:30 local on May 1st is 21:30 UTC, outside; 00:30 local on May 2nd
+	// is still May 1st in UTC, inside
+	if r.Contains(time.Date(2024, 5, 1, 21, 30, 0, 0, time.UTC)) {
+		t.Error("21:30 UTC on May 1st is May 1st locally")
+	}
+	if !r.Contains(time.Date(2024, 5, 1, 22, 30, 0, 0, time.UTC)) {
+		t.Error("22:30 UTC on May 1st is May 2nd locally")
+	}
+
+	after, before := r.APIBounds()
+	if after != "2024-04-30" || before != "2024-05-03" {
+		t.Errorf("APIBounds = %s, %s", after, before)
+	}
+}
@@ -0,0 +1,270 @@
+// Package webhook receives GitLab webhook deliveries and turns them into the
+// activity event model used by `activity list`.
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/user/gitlab-cli/internal/gitlab"
+)
+
+// ErrUnsupportedEvent is returned by Parse for event kinds other than merge
+// request, pipeline, note and push hooks.
+var ErrUnsupportedEvent = errors.New("unsupported webhook event")
+
+// Event is a normalized webhook delivery: an activity entry plus the IDs
+// needed to route it to the MR it concerns. MRIID is 0 for events that are
+// not tied to a merge request.
+type Event struct {
+	gitlab.ActivityEntry
+	Kind        string `json:"kind"`
+	ProjectID   int    `json:"project_id"`
+	ProjectPath string `json:"project_path"`
+	MRIID       int    `json:"mr_iid,omitempty"`
+}
+
+type hookProject struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+type hookUser struct {
+	Username string `json:"username"`
+}
+
+type hookMR struct {
+	IID          int    `json:"iid"`
+	Title        string `json:"title"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	URL          string `json:"url"`
+}
+
+type mergeRequestHook struct {
+	User             hookUser    `json:"user"`
+	Project          hookProject `json:"project"`
+	ObjectAttributes struct {
+		hookMR
+		State               string `json:"state"`
+		Action              string `json:"action"`
+		DetailedMergeStatus string `json:"detailed_merge_status"`
+		UpdatedAt           string `json:"updated_at"`
+	} `json:"object_attributes"`
+}
+
+type pipelineHook struct {
+	User             hookUser    `json:"user"`
+	Project          hookProject `json:"project"`
+	MergeRequest     *hookMR     `json:"merge_request"`
+	ObjectAttributes struct {
+		ID         int    `json:"id"`
+		Ref        string `json:"ref"`
+		SHA        string `json:"sha"`
+		Status     string `json:"status"`
+		CreatedAt  string `json:"created_at"`
+		FinishedAt string `json:"finished_at"`
+		URL        string `json:"url"`
+	} `json:"object_attributes"`
+}
+
+type noteHook struct {
+	User             hookUser    `json:"user"`
+	Project          hookProject `json:"project"`
+	MergeRequest     *hookMR     `json:"merge_request"`
+	Issue            *hookMR     `json:"issue"`
+	ObjectAttributes struct {
+		Note         string `json:"note"`
+		NoteableType string `json:"noteable_type"`
+		URL          string `json:"url"`
+		CreatedAt    string `json:"created_at"`
+	} `json:"object_attributes"`
+}
+
+type pushHook struct {
+	Ref               string      `json:"ref"`
+	Before            string      `json:"before"`
+	After             string      `json:"after"`
+	UserUsername      string      `json:"user_username"`
+	TotalCommitsCount int         `json:"total_commits_count"`
+	Project           hookProject `json:"project"`
+	Commits           []struct {
+		Title     string `json:"title"`
+		Timestamp string `json:"timestamp"`
+	} `json:"commits"`
+}
+
+// mrActions maps merge request hook actions to the action names of the
+// events API, so webhook and polled activity look the same.
+var mrActions = map[string]string{
+	"open":       "opened",
+	"reopen":     "reopened",
+	"close":      "closed",
+	"merge":      "accepted",
+	"update":     "updated",
+	"approved":   "approved",
+	"approval":   "approved",
+	"unapproved": "unapproved",
+	"unapproval": "unapproved",
+}
+
+// zeroSHA is the before/after commit of pushes that create or delete a ref.
+const zeroSHA = "0000000000000000000000000000000000000000"
+
+// Parse normalizes a delivery. kind is the X-Gitlab-Event header value;
+// now is used when the payload carries no usable timestamp, and its location
+// is the zone of the event's date and time.
+func Parse(kind string, body []byte, now time.Time) (Event, error) {
+	switch kind {
+	case "Merge Request Hook":
+		var h mergeRequestHook
+		if err := json.Unmarshal(body, &h); err != nil {
+			return Event{}, fmt.Errorf("decoding %s: %w", kind, err)
+		}
+		a := h.ObjectAttributes
+		action := mrActions[a.Action]
+		if action == "" {
+			action = a.Action
+		}
+		return newEvent("merge_request", h.Project, parseTime(a.UpdatedAt, now), gitlab.ActivityEntry{
+			Type:        action,
+			Source:      a.SourceBranch,
+			Target:      a.TargetBranch,
+			Description: fmt.Sprintf("MR !%d: %s", a.IID, a.Title),
+			Details: map[string]interface{}{
+				"mr_iid":                a.IID,
+				"title":                 a.Title,
+				"state":                 a.State,
+				"detailed_merge_status": a.DetailedMergeStatus,
+				"author":                h.User.Username,
+				"web_url":               a.URL,
+			},
+		}, a.IID), nil
+
+	case "Pipeline Hook":
+		var h pipelineHook
+		if err := json.Unmarshal(body, &h); err != nil {
+			return Event{}, fmt.Errorf("decoding %s: %w", kind, err)
+		}
+		a := h.ObjectAttributes
+		at := a.FinishedAt
+		if at == "" {
+			at = a.CreatedAt
+		}
+		entry := gitlab.ActivityEntry{
+			Type:        "pipeline",
+			Source:      a.Ref,
+			Description: fmt.Sprintf("pipeline %s", a.Status),
+			Details: map[string]interface{}{
+				"pipeline_id": a.ID,
+				"status":      a.Status,
+				"sha":         a.SHA,
+				"web_url":     a.URL,
+				"author":      h.User.Username,
+			},
+		}
+		iid := 0
+		if h.MergeRequest != nil {
+			iid = h.MergeRequest.IID
+			entry.Target = fmt.Sprintf("!%d", iid)
+			entry.Details["mr_iid"] = iid
+			entry.Details["title"] = h.MergeRequest.Title
+		}
+		return newEvent("pipeline", h.Project, parseTime(at, now), entry, iid), nil
+
+	case "Note Hook":
+		var h noteHook
+		if err := json.Unmarshal(body, &h); err != nil {
+			return Event{}, fmt.Errorf("decoding %s: %w", kind, err)
+		}
+		a := h.ObjectAttributes
+		noteType := strings.ToLower(a.NoteableType)
+		entry := gitlab.ActivityEntry{
+			Type:        "commented on",
+			Description: fmt.Sprintf("commented on %s", noteType),
+			Details: map[string]interface{}{
+				"noteable_type": noteType,
+				"body":          a.Note,
+				"author":        h.User.Username,
+				"web_url":       a.URL,
+			},
+		}
+		iid := 0
+		switch {
+		case h.MergeRequest != nil:
+			iid = h.MergeRequest.IID
+			entry.Source = h.MergeRequest.SourceBranch
+			entry.Target = h.MergeRequest.TargetBranch
+			entry.Description += ": " + h.MergeRequest.Title
+			entry.Details["mr_iid"] = iid
+			entry.Details["title"] = h.MergeRequest.Title
+		case h.Issue != nil:
+			entry.Description += ": " + h.Issue.Title
+			entry.Details["issue_iid"] = h.Issue.IID
+			entry.Details["title"] = h.Issue.Title
+		}
+		return newEvent("note", h.Project, parseTime(a.CreatedAt, now), entry, iid), nil
+
+	case "Push Hook":
+		var h pushHook
+		if err := json.Unmarshal(body, &h); err != nil {
+			return Event{}, fmt.Errorf("decoding %s: %w", kind, err)
+		}
+		branch := strings.TrimPrefix(h.Ref, "refs/heads/")
+		entry := gitlab.ActivityEntry{
+			Type:   "pushed to",
+			Source: branch,
+			Details: map[string]interface{}{
+				"branch": branch,
+				"author": h.UserUsername,
+			},
+		}
+		switch {
+		case h.After == zeroSHA:
+			entry.Type = "deleted"
+			entry.Description = "deleted branch"
+			entry.Details["action"] = "deleted"
+		case h.Before == zeroSHA:
+			entry.Type = "pushed new"
+			entry.Description = "created branch"
+			entry.Details["action"] = "created"
+		default:
+			entry.Description = fmt.Sprintf("%d commit(s)", h.TotalCommitsCount)
+			entry.Details["commits"] = h.TotalCommitsCount
+		}
+		at := ""
+		if n := len(h.Commits); n > 0 {
+			at = h.Commits[n-1].Timestamp
+			entry.Details["commit_title"] = h.Commits[n-1].Title
+		}
+		return newEvent("push", h.Project, parseTime(at, now), entry, 0), nil
+	}
+
+	return Event{}, fmt.Errorf("%w: %q", ErrUnsupportedEvent, kind)
+}
+
+func newEvent(kind string, project hookProject, t time.Time, entry gitlab.ActivityEntry, iid int) Event {
+	entry.Date = t.Format("2006-01-02")
+	entry.Time = t.Format("15:04")
+	entry.Project = project.Name
+	if entry.Project == "" {
+		entry.Project = project.PathWithNamespace
+	}
+	return Event{ActivityEntry: entry, Kind: kind, ProjectID: project.ID, ProjectPath: project.PathWithNamespace, MRIID: iid}
+}
+
+// parseTime accepts the timestamp formats found in webhook payloads, which
+// differ between event types and GitLab versions. The result is in the
+// location of fallback.
+func parseTime(s string, fallback time.Time) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.In(fallback.Location())
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// maxPayloadSize bounds the request bodies read. Push hooks carry at most 20
+// commits, so real deliveries stay far below it.
+const maxPayloadSize = 5 << 20
+
+// Handler accepts GitLab webhook deliveries. Deliveries with a wrong
+// X-Gitlab-Token get 401; unsupported event kinds are acknowledged and
+// dropped so that GitLab does not disable the hook.
+type Handler struct {
+	// Secret is compared with X-Gitlab-Token. Empty accepts any delivery.
+	Secret string
+	// OnEvent is called for every parsed event on the request goroutine and
+	// must not block; GitLab times out slow receivers.
+	OnEvent func(Event)
+	// OnError, when set, is told about deliveries that could not be parsed.
+	OnError func(error)
+	// Location is the zone of event dates and times; nil means UTC.
+	Location *time.Location
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.Secret != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), []byte(h.Secret)) != 1 {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+
+	loc := h.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	event, err := Parse(r.Header.Get("X-Gitlab-Event"), body, time.Now().In(loc))
+	if errors.Is(err, ErrUnsupportedEvent) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		if h.OnError != nil {
+			h.OnError(err)
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.OnEvent != nil {
+		h.OnEvent(event)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Listen starts serving h on addr and returns once the listener is open, so
+// that address errors surface immediately. The server shuts down when ctx is
+// done; later serve errors are sent on the returned channel.
+func Listen(ctx context.Context, addr string, h http.Handler) (<-chan error, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", addr, err)
+	}
+
+	srv := &http.Server{Handler: h, ReadHeaderTimeout: 10 * time.Second}
+	errc := make(chan error, 1)
+
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errc <- err
+		}
+		close(errc)
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	return errc, nil
+}
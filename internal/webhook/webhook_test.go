@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		body     string
+		wantKind string
+		wantType string
+		wantDesc string
+		wantMR   int
+		wantTime string
+	}{
+		{
+			name: "merge request merged",
+			kind: "Merge Request Hook",
+			body: `{"object_kind":"merge_request","user":{"username":"alice"},
+				"project":{"id":42,"name":"api"},
+				"object_attributes":{"iid":7,"title":"Add cache","state":"merged","action":"merge",
+					"source_branch":"feature/12345-cache","target_branch":"main",
+					"updated_at":"2024-05-01 09:30:00 UTC"}}`,
+			wantKind: "merge_request",
+			wantType: "accepted",
+			wantDesc: "MR !7: Add cache",
+			wantMR:   7,
+			wantTime: "2024-05-01 09:30",
+		},
+		{
+			name: "pipeline of an MR",
+			kind: "Pipeline Hook",
+			body: `{"object_kind":"pipeline","project":{"id":42,"name":"api"},
+				"merge_request":{"iid":7,"title":"Add cache"},
+				"object_attributes":{"id":99,"ref":"feature/12345-cache","status":"success",
+					"finished_at":"2024-05-01T10:00:00+02:00"}}`,
+			wantKind: "pipeline",
+			wantType: "pipeline",
+			wantDesc: "pipeline success",
+			wantMR:   7,
+			wantTime: "2024-05-01 08:00",
+		},
+		{
+			name: "note on MR",
+			kind: "Note Hook",
+			body: `{"object_kind":"note","project":{"id":42,"name":"api"},
+				"merge_request":{"iid":7,"title":"Add cache"},
+				"object_attributes":{"note":"LGTM","noteable_type":"MergeRequest"}}`,
+			wantKind: "note",
+			wantType: "commented on",
+			wantDesc: "commented on mergerequest: Add cache",
+			wantMR:   7,
+			wantTime: "2024-05-01 12:00",
+		},
+		{
+			name: "branch created",
+			kind: "Push Hook",
+			body: `{"object_kind":"push","ref":"refs/heads/feature/12345-cache",
+				"before":"0000000000000000000000000000000000000000","after":"abc",
+				"project":{"id":42,"name":"api"}}`,
+			wantKind: "push",
+			wantType: "pushed new",
+			wantDesc: "created branch",
+			wantTime: "2024-05-01 12:00",
+		},
+		{
+			name: "push with commits",
+			kind: "Push Hook",
+			body: `{"object_kind":"push","ref":"refs/heads/main","before":"abc","after":"def",
+				"total_commits_count":2,"project":{"id":42,"name":"api"},
+				"commits":[{"title":"one","timestamp":"2024-05-01T07:00:00Z"},{"title":"two","timestamp":"2024-05-01T07:05:00Z"}]}`,
+			wantKind: "push",
+			wantType: "pushed to",
+			wantDesc: "2 commit(s)",
+			wantTime: "2024-05-01 07:05",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.kind, []byte(tt.body), testNow)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e.Kind != tt.wantKind || e.Type != tt.wantType || e.Description != tt.wantDesc {
+				t.Errorf("got kind %q type %q description %q", e.Kind, e.Type, e.Description)
+			}
+			if e.ProjectID != 42 || e.Project != "api" || e.MRIID != tt.wantMR {
+				t.Errorf("got project %d %q, MR %d", e.ProjectID, e.Project, e.MRIID)
+			}
+			if got := e.Date + " " + e.Time; got != tt.wantTime {
+				t.Errorf("time = %s, want %s", got, tt.wantTime)
+			}
+		})
+	}
+}
+
+func TestParseInZone(t *testing.T) {
+	now := testNow.In(time.FixedZone("UTC+2", 2*3600))
+	body := `{"object_kind":"push","ref":"refs/heads/main","before":"abc","after":"def",
+		"total_commits_count":1,"project":{"id":42,"name":"api"},
+		"commits":[{"title":"late","timestamp":"2024-05-01T22:30:00Z"}]}`
+
+	e, err := Parse("Push Hook", []byte(body), now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := e.Date + " " + e.Time; got != "2024-05-02 00:30" {
+		t.Errorf("time = %s, want 2024-05-02 00:30", got)
+	}
+}
+
+func TestParseUnsupported(t *testing.T) {
+	if _, err := Parse("Wiki Page Hook", []byte(`{}`), testNow); !errors.Is(err, ErrUnsupportedEvent) {
+		t.Errorf("expected ErrUnsupportedEvent, got %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var got []Event
+	h := &Handler{Secret: "s3cret", OnEvent: func(e Event) { got = append(got, e) }}
+
+	body := `{"project":{"id":1},"object_attributes":{"iid":3,"action":"open"}}`
+	tests := []struct {
+		name   string
+		token  string
+		kind   string
+		want   int
+		events int
+	}{
+		{"wrong token", "nope", "Merge Request Hook", http.StatusUnauthorized, 0},
+		{"valid", "s3cret", "Merge Request Hook", http.StatusNoContent, 1},
+		{"unsupported kind", "s3cret", "Job Hook", http.StatusNoContent, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			req.Header.Set("X-Gitlab-Token", tt.token)
+			req.Header.Set("X-Gitlab-Event", tt.kind)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if len(got) != tt.events {
+				t.Errorf("events = %d, want %d", len(got), tt.events)
+			}
+		})
+	}
+
+	if len(got) == 1 && (got[0].Type != "opened" || got[0].MRIID != 3) {
+		t.Errorf("unexpected event: %+v", got[0])
+	}
+}